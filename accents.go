@@ -0,0 +1,125 @@
+package rae
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+
+	"github.com/rae-api-com/go-rae/collate"
+)
+
+// AccentIndex maps the accent-insensitive form of headwords, see
+// collate.Key, to the headwords themselves. It is safe for concurrent use.
+type AccentIndex struct {
+	mu    sync.RWMutex
+	byKey map[string][]string
+}
+
+// NewAccentIndex returns an index of headwords, typically those of a
+// snapshot.
+func NewAccentIndex(headwords ...string) *AccentIndex {
+	idx := &AccentIndex{byKey: map[string][]string{}}
+	idx.Add(headwords...)
+	return idx
+}
+
+// Add indexes headwords, folded as by collate.Fold. Headwords already in the
+// index are skipped.
+func (idx *AccentIndex) Add(headwords ...string) {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+
+	for _, h := range headwords {
+		h = collate.Fold(h)
+		key := collate.StripAccents(h)
+		if !slices.Contains(idx.byKey[key], h) {
+			idx.byKey[key] = append(idx.byKey[key], h)
+		}
+	}
+}
+
+// Lookup returns the headwords written like word but for accents and case,
+// in collation order.
+func (idx *AccentIndex) Lookup(word string) []string {
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+
+	candidates := slices.Clone(idx.byKey[collate.Key(word)])
+	collate.Sort(candidates)
+
+	return candidates
+}
+
+// AmbiguousWordError is returned when an unaccented word matches several
+// headwords, such as "esta" for "está" and "ésta". It unwraps to
+// ErrAmbiguousWord.
+type AmbiguousWordError struct {
+	Word       string
+	Candidates []string
+}
+
+func (e *AmbiguousWordError) Error() string {
+	return fmt.Sprintf("%q matches several headwords: %v", e.Word, e.Candidates)
+}
+
+func (e *AmbiguousWordError) Unwrap() error {
+	return ErrAmbiguousWord
+}
+
+// accentCandidates returns the headwords among words matching word but for
+// accents, leaving word itself out.
+func accentCandidates(word string, words []string) []string {
+	key := collate.Key(word)
+
+	var candidates []string
+	for _, w := range words {
+		w = collate.Fold(w)
+		if w != word && collate.Key(w) == key && !slices.Contains(candidates, w) {
+			candidates = append(candidates, w)
+		}
+	}
+	collate.Sort(candidates)
+
+	return candidates
+}
+
+// lookupRestoringAccents looks up word, resolving it to the accented headword it
+// stands for when there is exactly one. The index is tried first, which
+// saves a round trip, then the suggestions of the API.
+func (c *Client) lookupRestoringAccents(ctx context.Context, word string) (WordEntry, error) {
+	normalized, err := normalizeWord(word)
+	if err != nil {
+		return WordEntry{Word: word}, err
+	}
+	word = normalized
+
+	if c.accentIndex != nil {
+		indexed := c.accentIndex.Lookup(word)
+		if !slices.Contains(indexed, word) {
+			switch candidates := accentCandidates(word, indexed); len(candidates) {
+			case 0:
+			case 1:
+				return c.lookup(ctx, candidates[0])
+			default:
+				return WordEntry{Word: word, Suggestions: candidates},
+					&AmbiguousWordError{Word: word, Candidates: candidates}
+			}
+		}
+	}
+
+	entry, err := c.lookup(ctx, word)
+	if err == nil || !errors.Is(err, ErrWordNotFound) {
+		return entry, err
+	}
+
+	switch candidates := accentCandidates(word, entry.Suggestions); len(candidates) {
+	case 0:
+		return entry, err
+	case 1:
+		return c.lookup(ctx, candidates[0])
+	default:
+		return entry, &AmbiguousWordError{Word: word, Candidates: candidates}
+	}
+}
@@ -0,0 +1,94 @@
+package rae_test
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+
+	rae "github.com/rae-api-com/go-rae"
+	"github.com/rae-api-com/go-rae/raetest"
+)
+
+func accentEntries() []rae.WordEntry {
+	entries := raetest.Entries()
+	for _, word := range []string{"está", "ésta", "país"} {
+		entries = append(entries, rae.WordEntry{
+			Word: word,
+			Meanings: []rae.Meaning{{
+				Definitions: []rae.Definition{{MeaningNumber: 1, Description: word}},
+			}},
+		})
+	}
+	return entries
+}
+
+func TestAccentRestoration(t *testing.T) {
+	server := raetest.NewServer(accentEntries()...)
+	defer server.Close()
+
+	index := rae.NewAccentIndex("camión", "casa", "está", "ésta", "país")
+
+	clients := map[string]*rae.Client{
+		"suggestions": rae.New(rae.WithBaseURL(server.URL), rae.WithAccentRestoration(nil)),
+		"index":       rae.New(rae.WithBaseURL(server.URL), rae.WithAccentRestoration(index)),
+	}
+
+	for name, cli := range clients {
+		for input, want := range map[string]string{
+			"camion": "camión",
+			"Pais":   "país",
+			"casa":   "casa",
+		} {
+			entry, err := cli.Word(context.Background(), input)
+			if err != nil {
+				t.Errorf("%s: %q: %v", name, input, err)
+				continue
+			}
+			if entry.Word != want {
+				t.Errorf("%s: %q: expected %q, got %q", name, input, want, entry.Word)
+			}
+		}
+
+		_, err := cli.Word(context.Background(), "esta")
+		var ambiguous *rae.AmbiguousWordError
+		if !errors.As(err, &ambiguous) || !errors.Is(err, rae.ErrAmbiguousWord) {
+			t.Fatalf("%s: expected an ambiguous error, got %v", name, err)
+		}
+		if !slices.Equal(ambiguous.Candidates, []string{"está", "ésta"}) {
+			t.Errorf("%s: unexpected candidates %v", name, ambiguous.Candidates)
+		}
+
+		if _, err := cli.Word(context.Background(), "perro"); !errors.Is(err, rae.ErrWordNotFound) {
+			t.Errorf("%s: expected not found, got %v", name, err)
+		}
+	}
+}
+
+func TestAccentRestorationDisabled(t *testing.T) {
+	server := raetest.NewServer()
+	defer server.Close()
+
+	cli := rae.New(rae.WithBaseURL(server.URL))
+	entry, err := cli.Word(context.Background(), "camion")
+	if !errors.Is(err, rae.ErrWordNotFound) {
+		t.Fatalf("expected not found, got %v", err)
+	}
+	if !slices.Contains(entry.Suggestions, "camión") {
+		t.Errorf("expected camión among the suggestions, got %v", entry.Suggestions)
+	}
+}
+
+func TestAccentIndex(t *testing.T) {
+	index := rae.NewAccentIndex("Árbol", "árbol", "esta", "está", "ésta", "caña")
+
+	if got := index.Lookup("ARBOL"); !slices.Equal(got, []string{"árbol"}) {
+		t.Errorf("unexpected %v", got)
+	}
+	if got := index.Lookup("esta"); !slices.Equal(got, []string{"esta", "está", "ésta"}) {
+		t.Errorf("unexpected %v", got)
+	}
+	if got := index.Lookup("cana"); len(got) != 0 {
+		t.Errorf("ñ must not match n, got %v", got)
+	}
+}
@@ -0,0 +1,132 @@
+// Package analysis turns Spanish text into the terms used by the local
+// search indexes: it splits words, folds case and accents, drops stop
+// words and reduces words to a common stem, so "espacios cerrados" and
+// "espacio cerrado" share their terms.
+package analysis
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/rae-api-com/go-rae/collate"
+)
+
+// Token is a word of a text. Start and End are the byte offsets of the
+// word in the text and Term is its folded form, or its stem after Analyze.
+type Token struct {
+	Term  string
+	Start int
+	End   int
+}
+
+// Tokenize splits text into words, folding their case and accents. Digits
+// are part of words; everything else separates them.
+func Tokenize(text string) []Token {
+	var tokens []Token
+
+	start := -1
+	for i, r := range text {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) || unicode.Is(unicode.Mn, r) {
+			if start < 0 {
+				start = i
+			}
+			continue
+		}
+		if start >= 0 {
+			tokens = append(tokens, token(text, start, i))
+			start = -1
+		}
+	}
+	if start >= 0 {
+		tokens = append(tokens, token(text, start, len(text)))
+	}
+
+	return tokens
+}
+
+func token(text string, start, end int) Token {
+	return Token{Term: collate.Key(text[start:end]), Start: start, End: end}
+}
+
+// Analyze tokenizes text, drops stop words and stems the rest.
+func Analyze(text string) []Token {
+	tokens := Tokenize(text)
+
+	out := tokens[:0]
+	for _, t := range tokens {
+		if IsStopWord(t.Term) {
+			continue
+		}
+		t.Term = Stem(t.Term)
+		out = append(out, t)
+	}
+
+	return out
+}
+
+// Terms returns the terms of Analyze.
+func Terms(text string) []string {
+	tokens := Analyze(text)
+	terms := make([]string, len(tokens))
+	for i, t := range tokens {
+		terms[i] = t.Term
+	}
+	return terms
+}
+
+// IsStopWord reports whether the folded word carries no meaning of its own,
+// such as articles, prepositions and conjunctions.
+func IsStopWord(word string) bool {
+	return stopWords[word]
+}
+
+var stopWords = map[string]bool{}
+
+func init() {
+	for _, w := range strings.Fields(`
+		a al algo algun alguna algunas alguno algunos ante antes aquel aquella
+		aquellas aquello aquellos asi aun aunque cada como con contra cual
+		cuales cuando de del desde donde dos el ella ellas ello ellos en entre
+		era eran es esa esas ese eso esos esta estas este esto estos fue fueron
+		ha han hasta hay la las le les lo los mas me mi mis mucho muy nada ni
+		no nos o os otra otras otro otros para pero poco por porque que quien
+		se sea segun ser si sin sobre su sus tambien tan te tiene tienen todo
+		todos tu tus u un una unas uno unos y ya`) {
+		stopWords[w] = true
+	}
+}
+
+// Stem reduces a folded word to its stem with a light Spanish stemmer: it
+// removes plurals, the adverbial -mente and the final vowel that marks
+// gender, so "cerrados", "cerrada" and "cerrado" share the stem "cerrad".
+// It does not try to relate verb forms, which the API lists as
+// conjugations.
+func Stem(word string) string {
+	if utf8.RuneCountInString(word) <= 3 {
+		return word
+	}
+
+	switch {
+	case strings.HasSuffix(word, "mente") && len(word) > 8:
+		word = strings.TrimSuffix(word, "mente")
+	case strings.HasSuffix(word, "ces") && len(word) > 4:
+		word = strings.TrimSuffix(word, "ces") + "z"
+	case strings.HasSuffix(word, "iones"):
+		word = strings.TrimSuffix(word, "es")
+	case strings.HasSuffix(word, "es") && len(word) > 4 && isConsonant(word[len(word)-3]):
+		word = strings.TrimSuffix(word, "es")
+	case strings.HasSuffix(word, "s") && len(word) > 4:
+		word = strings.TrimSuffix(word, "s")
+	}
+
+	if n := len(word); n > 4 && strings.ContainsRune("aeo", rune(word[n-1])) {
+		word = word[:n-1]
+	}
+
+	return word
+}
+
+func isConsonant(b byte) bool {
+	return b >= 'a' && b <= 'z' && !strings.ContainsRune("aeiou", rune(b))
+}
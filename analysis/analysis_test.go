@@ -0,0 +1,56 @@
+package analysis
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestTokenize(t *testing.T) {
+	text := "Miedo a los ESPACIOS, cerrados."
+	want := []Token{
+		{"miedo", 0, 5},
+		{"a", 6, 7},
+		{"los", 8, 11},
+		{"espacios", 12, 20},
+		{"cerrados", 22, 30},
+	}
+	if got := Tokenize(text); !slices.Equal(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+
+	tokens := Tokenize("canción")
+	if len(tokens) != 1 || tokens[0].Term != "cancion" || tokens[0].End != len("canción") {
+		t.Errorf("accents: got %v", tokens)
+	}
+}
+
+func TestStem(t *testing.T) {
+	groups := [][]string{
+		{"cerrado", "cerrados", "cerrada", "cerradas"},
+		{"espacio", "espacios"},
+		{"ciudad", "ciudades"},
+		{"luz", "luces"},
+		{"cancion", "canciones"},
+		{"rapido", "rapidamente"},
+	}
+
+	for _, g := range groups {
+		for _, w := range g[1:] {
+			if Stem(w) != Stem(g[0]) {
+				t.Errorf("Stem(%q) = %q, Stem(%q) = %q", w, Stem(w), g[0], Stem(g[0]))
+			}
+		}
+	}
+
+	if Stem("casa") == Stem("caso") {
+		t.Error("short words should keep their final vowel")
+	}
+}
+
+func TestTerms(t *testing.T) {
+	got := Terms("Miedo a los espacios cerrados")
+	want := []string{"mied", "espaci", "cerrad"}
+	if !slices.Equal(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,163 @@
+package autocomplete
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	rae "github.com/rae-api-com/go-rae"
+	"github.com/rae-api-com/go-rae/raetest"
+)
+
+func TestComplete(t *testing.T) {
+	idx := NewIndex("casa", "casar", "casamiento", "caza", "canción", "cántaro", "papa", "papá", "casa")
+	if idx.Len() != 8 {
+		t.Fatalf("Len = %d, want 8", idx.Len())
+	}
+
+	tests := []struct {
+		prefix string
+		n      int
+		want   []string
+	}{
+		{"cas", 10, []string{"casa", "casar", "casamiento"}},
+		{"CAS", 2, []string{"casa", "casar"}},
+		{"cancion", 10, []string{"canción"}},
+		{"cán", 10, []string{"canción", "cántaro"}},
+		{"pap", 10, []string{"papa", "papá"}},
+		{"x", 10, nil},
+		{"ca", 0, nil},
+	}
+
+	for _, tt := range tests {
+		if got := idx.Complete(tt.prefix, tt.n); !slices.Equal(got, tt.want) {
+			t.Errorf("Complete(%q, %d) = %v, want %v", tt.prefix, tt.n, got, tt.want)
+		}
+	}
+
+	idx.Insert("casamiento", 5)
+	idx.Insert("casamiento", 1) // weights never decrease
+	if got := idx.Complete("cas", 2); !slices.Equal(got, []string{"casamiento", "casa"}) {
+		t.Errorf("weighted: got %v", got)
+	}
+}
+
+func TestCompleteBeyondTop(t *testing.T) {
+	idx := NewIndex()
+	for i := 0; i < 2*topSize; i++ {
+		idx.Insert(fmt.Sprintf("a%03d", i), i)
+	}
+
+	got := idx.Complete("a", 2*topSize)
+	if len(got) != 2*topSize || got[0] != "a063" || got[len(got)-1] != "a000" {
+		t.Fatalf("got %d completions: %v", len(got), got)
+	}
+	if top := idx.Complete("a", 3); !slices.Equal(top, got[:3]) {
+		t.Errorf("top = %v, want %v", top, got[:3])
+	}
+}
+
+func TestCompleterFallsBackToSearch(t *testing.T) {
+	server := raetest.NewServer()
+	defer server.Close()
+
+	cli := rae.New(rae.WithBaseURL(server.URL))
+
+	var searches atomic.Int32
+	c := &Completer{
+		Index: NewIndex("casa"),
+		Delay: time.Millisecond,
+		Search: func(ctx context.Context, terms string) ([]rae.SearchResult, error) {
+			searches.Add(1)
+			return cli.Search(ctx, terms)
+		},
+	}
+
+	words, err := c.Complete(context.Background(), "cas")
+	if err != nil || !slices.Equal(words, []string{"casa"}) {
+		t.Fatalf("cas: %v, %v", words, err)
+	}
+	if searches.Load() != 0 {
+		t.Fatal("local completions should not search")
+	}
+
+	words, err = c.Complete(context.Background(), "cami")
+	if err != nil || !slices.Equal(words, []string{"camión"}) {
+		t.Fatalf("cami: %v, %v", words, err)
+	}
+	if searches.Load() != 1 {
+		t.Fatalf("searches = %d, want 1", searches.Load())
+	}
+
+	// learnt from the search
+	if _, err := c.Complete(context.Background(), "camio"); err != nil || searches.Load() != 1 {
+		t.Fatalf("camio: %v, searches = %d", err, searches.Load())
+	}
+}
+
+func TestCompleterZeroValue(t *testing.T) {
+	c := &Completer{
+		Delay: time.Millisecond,
+		Search: func(ctx context.Context, terms string) ([]rae.SearchResult, error) {
+			return []rae.SearchResult{{Doc: rae.Document{Word: "casa"}}}, nil
+		},
+	}
+
+	words, err := c.Complete(context.Background(), "ca")
+	if err != nil || !slices.Equal(words, []string{"casa"}) {
+		t.Fatalf("got %v, %v", words, err)
+	}
+}
+
+func TestCompleterSupersedes(t *testing.T) {
+	started := make(chan struct{})
+	var searched []string
+	c := &Completer{
+		Index: NewIndex(),
+		Delay: time.Millisecond,
+		Search: func(ctx context.Context, terms string) ([]rae.SearchResult, error) {
+			searched = append(searched, terms)
+			if terms == "h" {
+				// hold the first search until the next input cancels it
+				close(started)
+				<-ctx.Done()
+				return nil, ctx.Err()
+			}
+			return nil, nil
+		},
+	}
+
+	errs := make(chan error, 1)
+	go func() {
+		_, err := c.Complete(context.Background(), "h")
+		errs <- err
+	}()
+
+	<-started
+	if _, err := c.Complete(context.Background(), "ho"); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := <-errs; !errors.Is(err, ErrSuperseded) {
+		t.Errorf("first call: got %v, want ErrSuperseded", err)
+	}
+	if !slices.Equal(searched, []string{"h", "ho"}) {
+		t.Errorf("searched %v, want [h ho]", searched)
+	}
+}
+
+func BenchmarkComplete(b *testing.B) {
+	idx := NewIndex()
+	for i := 0; i < 100000; i++ {
+		idx.Insert(fmt.Sprintf("palabra%d", i), i%97)
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		idx.Complete("palabra1", 10)
+	}
+}
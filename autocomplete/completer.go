@@ -0,0 +1,129 @@
+package autocomplete
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"time"
+
+	rae "github.com/rae-api-com/go-rae"
+	"github.com/rae-api-com/go-rae/collate"
+)
+
+// ErrSuperseded is returned by Completer.Complete when a newer input arrived
+// before the search for the previous one was sent.
+var ErrSuperseded = errors.New("autocomplete: superseded by a newer input")
+
+// SearchFunc searches the API, such as Client.Search.
+type SearchFunc func(ctx context.Context, terms string) ([]rae.SearchResult, error)
+
+// Completer answers every keystroke from the local index and searches the
+// API only when the index has no completions and the input has not changed
+// for Delay. Headwords found by the API are added to the index, so they
+// complete locally from then on.
+type Completer struct {
+	// Index defaults to an empty index, filled by the searches.
+	Index *Index
+	// Limit is the number of completions returned, 10 when zero.
+	Limit int
+	// Delay is how long the input must stay unchanged before searching the
+	// API, 250ms when zero.
+	Delay time.Duration
+	// Search defaults to GetSearch.
+	Search SearchFunc
+
+	mu      sync.Mutex
+	pending int
+	cancel  context.CancelCauseFunc
+}
+
+// Complete returns the completions of prefix. A call waiting to search the
+// API fails with ErrSuperseded as soon as Complete is called again.
+func (c *Completer) Complete(ctx context.Context, prefix string) ([]string, error) {
+	limit, delay, search := c.Limit, c.Delay, c.Search
+	if limit <= 0 {
+		limit = 10
+	}
+	if delay <= 0 {
+		delay = 250 * time.Millisecond
+	}
+	if search == nil {
+		search = func(ctx context.Context, terms string) ([]rae.SearchResult, error) {
+			return rae.GetSearch(ctx, "dev", terms)
+		}
+	}
+
+	index := c.index()
+
+	ctx, id := c.supersede(ctx)
+	defer c.done(id)
+
+	if words := index.Complete(prefix, limit); len(words) > 0 {
+		return words, nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return nil, context.Cause(ctx)
+	case <-timer.C:
+	}
+
+	results, err := search(ctx, prefix)
+	if err != nil {
+		if errors.Is(context.Cause(ctx), ErrSuperseded) {
+			return nil, ErrSuperseded
+		}
+		return nil, err
+	}
+
+	// keep the hits that complete the prefix, the API also matches
+	// definitions
+	key := collate.Key(prefix)
+	for _, r := range results {
+		if strings.HasPrefix(collate.Key(r.Doc.Word), key) {
+			index.Add(r.Doc.Word)
+		}
+	}
+
+	return index.Complete(prefix, limit), nil
+}
+
+func (c *Completer) index() *Index {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.Index == nil {
+		c.Index = NewIndex()
+	}
+	return c.Index
+}
+
+// supersede cancels the pending call, if any, and registers a new one.
+func (c *Completer) supersede(ctx context.Context) (context.Context, int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.cancel != nil {
+		c.cancel(ErrSuperseded)
+	}
+
+	ctx, cancel := context.WithCancelCause(ctx)
+	c.pending++
+	c.cancel = cancel
+
+	return ctx, c.pending
+}
+
+func (c *Completer) done(id int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if id == c.pending && c.cancel != nil {
+		c.cancel(nil)
+		c.cancel = nil
+	}
+}
@@ -0,0 +1,201 @@
+// Package autocomplete completes headword prefixes from a local word list,
+// for search-as-you-type without a request per keystroke.
+package autocomplete
+
+import (
+	"sort"
+	"sync"
+
+	rae "github.com/rae-api-com/go-rae"
+	"github.com/rae-api-com/go-rae/collate"
+)
+
+// topSize is the number of best completions kept at every node, so that
+// Complete answers up to topSize completions without walking the subtree.
+const topSize = 32
+
+type completion struct {
+	word   string
+	key    string
+	weight int
+}
+
+// before reports whether a ranks before b: heavier first, then shorter,
+// then in dictionary order.
+func (a completion) before(b completion) bool {
+	if a.weight != b.weight {
+		return a.weight > b.weight
+	}
+	if la, lb := len([]rune(a.key)), len([]rune(b.key)); la != lb {
+		return la < lb
+	}
+	return collate.Compare(a.word, b.word) < 0
+}
+
+type node struct {
+	children map[rune]*node
+	words    []*completion // headwords ending here, e.g. "papa" and "papá"
+	top      []*completion // best completions in the subtree
+}
+
+// Index is a trie over headwords keyed by their accent and case insensitive
+// form, so "cancion" completes to "canción". It is safe for concurrent use.
+type Index struct {
+	mu    sync.RWMutex
+	root  node
+	words map[string]*completion
+}
+
+// NewIndex returns an index over words.
+func NewIndex(words ...string) *Index {
+	idx := &Index{}
+	idx.Add(words...)
+	return idx
+}
+
+// FromEntries returns an index over the headwords of entries, such as those
+// of a snapshot.
+func FromEntries(entries []rae.WordEntry) *Index {
+	words := make([]string, len(entries))
+	for i, e := range entries {
+		words[i] = e.Word
+	}
+	return NewIndex(words...)
+}
+
+// Add inserts words with a weight of zero.
+func (idx *Index) Add(words ...string) {
+	for _, w := range words {
+		idx.Insert(w, 0)
+	}
+}
+
+// Insert adds word with weight, such as how often it is looked up. Heavier
+// words complete first. Inserting a word again raises its weight to the
+// larger of both.
+func (idx *Index) Insert(word string, weight int) {
+	word = collate.Fold(word)
+	key := collate.Key(word)
+	if key == "" {
+		return
+	}
+
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+
+	if idx.words == nil {
+		idx.words = map[string]*completion{}
+	}
+
+	c, exists := idx.words[word]
+	if exists {
+		if weight <= c.weight {
+			return
+		}
+		c.weight = weight
+	} else {
+		c = &completion{word: word, key: key, weight: weight}
+		idx.words[word] = c
+	}
+
+	n := &idx.root
+	n.offer(c)
+	for _, r := range key {
+		child, ok := n.children[r]
+		if !ok {
+			if n.children == nil {
+				n.children = map[rune]*node{}
+			}
+			child = &node{}
+			n.children[r] = child
+		}
+		n = child
+		n.offer(c)
+	}
+	if !exists {
+		n.words = append(n.words, c)
+	}
+}
+
+// offer places c in the best completions of n. Weights only grow, so a
+// completion that drops out of top never deserves to come back.
+func (n *node) offer(c *completion) {
+	if i := indexOf(n.top, c); i >= 0 {
+		n.top = append(n.top[:i], n.top[i+1:]...)
+	}
+
+	i := sort.Search(len(n.top), func(i int) bool {
+		return c.before(*n.top[i])
+	})
+	if i >= topSize {
+		return
+	}
+
+	n.top = append(n.top, nil)
+	copy(n.top[i+1:], n.top[i:])
+	n.top[i] = c
+	if len(n.top) > topSize {
+		n.top = n.top[:topSize]
+	}
+}
+
+func indexOf(top []*completion, c *completion) int {
+	for i, x := range top {
+		if x == c {
+			return i
+		}
+	}
+	return -1
+}
+
+// Len returns the number of distinct words in the index.
+func (idx *Index) Len() int {
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+	return len(idx.words)
+}
+
+// Complete returns up to n headwords starting with prefix, ignoring accents
+// and case, best first. A word equal to the prefix is a completion too.
+func (idx *Index) Complete(prefix string, n int) []string {
+	if n <= 0 {
+		return nil
+	}
+
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+
+	node := &idx.root
+	for _, r := range collate.Key(prefix) {
+		node = node.children[r]
+		if node == nil {
+			return nil
+		}
+	}
+
+	top := node.top
+	if n > topSize {
+		top = nil
+		node.collect(&top)
+		sort.Slice(top, func(i, j int) bool {
+			return top[i].before(*top[j])
+		})
+	}
+
+	words := make([]string, 0, min(n, len(top)))
+	for _, c := range top {
+		if len(words) == n {
+			break
+		}
+		words = append(words, c.word)
+	}
+
+	return words
+}
+
+func (n *node) collect(out *[]*completion) {
+	*out = append(*out, n.words...)
+	for _, child := range n.children {
+		child.collect(out)
+	}
+}
@@ -0,0 +1,116 @@
+// Package changelog compares two snapshots of the dictionary and renders
+// what changed as JSON, Markdown, RSS or Atom.
+package changelog
+
+import (
+	"encoding/json"
+	"io"
+	"sort"
+	"time"
+
+	rae "github.com/rae-api-com/go-rae"
+)
+
+// EntryChange holds the changes of a headword present in both snapshots.
+type EntryChange struct {
+	Word    string      `json:"word"`
+	Changes rae.Changes `json:"changes"`
+}
+
+// UsageChange is a sense whose usage changed, e.g. one that became
+// rae.UsageObsolete.
+type UsageChange struct {
+	Word    string    `json:"word"`
+	Meaning int       `json:"meaning"`
+	Sense   int       `json:"sense"`
+	Old     rae.Usage `json:"old"`
+	New     rae.Usage `json:"new"`
+}
+
+// Changelog lists the differences between two snapshots. From and To are
+// free-form labels of the snapshots, such as their file names or dates.
+type Changelog struct {
+	From     string        `json:"from,omitempty"`
+	To       string        `json:"to,omitempty"`
+	Date     time.Time     `json:"date"`
+	Added    []string      `json:"added"`
+	Removed  []string      `json:"removed"`
+	Modified []EntryChange `json:"modified"`
+	Usage    []UsageChange `json:"usage"`
+}
+
+func (c Changelog) Empty() bool {
+	return len(c.Added) == 0 && len(c.Removed) == 0 && len(c.Modified) == 0
+}
+
+// Compare builds the changelog from the old snapshot to the new one. Entries
+// are matched by headword and sorted alphabetically.
+func Compare(old, new []rae.WordEntry) Changelog {
+	c := Changelog{
+		Added:    []string{},
+		Removed:  []string{},
+		Modified: []EntryChange{},
+		Usage:    []UsageChange{},
+	}
+
+	oldByWord := byWord(old)
+	newByWord := byWord(new)
+
+	for _, word := range sortedWords(oldByWord) {
+		if _, ok := newByWord[word]; !ok {
+			c.Removed = append(c.Removed, word)
+		}
+	}
+
+	for _, word := range sortedWords(newByWord) {
+		before, ok := oldByWord[word]
+		if !ok {
+			c.Added = append(c.Added, word)
+			continue
+		}
+
+		changes := rae.Diff(before, newByWord[word])
+		if changes.Empty() {
+			continue
+		}
+		c.Modified = append(c.Modified, EntryChange{Word: word, Changes: changes})
+
+		for _, change := range changes {
+			if change.Field == "usage" {
+				c.Usage = append(c.Usage, UsageChange{
+					Word:    word,
+					Meaning: change.Meaning,
+					Sense:   change.Sense,
+					Old:     rae.Usage(change.Old),
+					New:     rae.Usage(change.New),
+				})
+			}
+		}
+	}
+
+	return c
+}
+
+func byWord(entries []rae.WordEntry) map[string]rae.WordEntry {
+	m := make(map[string]rae.WordEntry, len(entries))
+	for _, e := range entries {
+		m[e.Word] = e
+	}
+	return m
+}
+
+func sortedWords(m map[string]rae.WordEntry) []string {
+	words := make([]string, 0, len(m))
+	for w := range m {
+		words = append(words, w)
+	}
+	sort.Strings(words)
+	return words
+}
+
+// WriteJSON writes the changelog as indented JSON.
+func (c Changelog) WriteJSON(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(c)
+}
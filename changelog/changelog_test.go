@@ -0,0 +1,114 @@
+package changelog
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"strings"
+	"testing"
+	"time"
+
+	rae "github.com/rae-api-com/go-rae"
+	"github.com/rae-api-com/go-rae/raetest"
+)
+
+func snapshots() ([]rae.WordEntry, []rae.WordEntry) {
+	old := raetest.Entries()
+	new := raetest.Entries()
+
+	// casa is removed, hablar gets an obsolete sense and perro is added
+	new = append(new[:1], new[2:]...)
+	new[0].Meanings[0].Definitions[1].Usage = rae.UsageObsolete
+	new = append(new, rae.WordEntry{
+		Word: "perro",
+		Meanings: []rae.Meaning{{
+			Definitions: []rae.Definition{{MeaningNumber: 1, Description: "Mamífero doméstico."}},
+		}},
+	})
+
+	return old, new
+}
+
+func TestCompare(t *testing.T) {
+	old, new := snapshots()
+	c := Compare(old, new)
+
+	if strings.Join(c.Added, ",") != "perro" {
+		t.Errorf("unexpected added %v", c.Added)
+	}
+	if strings.Join(c.Removed, ",") != "casa" {
+		t.Errorf("unexpected removed %v", c.Removed)
+	}
+	if len(c.Modified) != 1 || c.Modified[0].Word != "hablar" {
+		t.Fatalf("unexpected modified %+v", c.Modified)
+	}
+
+	want := UsageChange{Word: "hablar", Meaning: 1, Sense: 2, Old: rae.UsageCommon, New: rae.UsageObsolete}
+	if len(c.Usage) != 1 || c.Usage[0] != want {
+		t.Fatalf("unexpected usage changes %+v", c.Usage)
+	}
+
+	if !Compare(old, old).Empty() {
+		t.Error("expected an empty changelog for equal snapshots")
+	}
+}
+
+func TestRender(t *testing.T) {
+	old, new := snapshots()
+	c := Compare(old, new)
+	c.From, c.To = "2025", "2026"
+	c.Date = time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+
+	var buf bytes.Buffer
+	if err := c.WriteMarkdown(&buf); err != nil {
+		t.Fatal(err)
+	}
+	for _, s := range []string{
+		"1 added, 1 removed, 1 modified headwords.",
+		"- **hablar** 1.2: common → obsolete",
+		"### hablar",
+		"Changed sense 1.2 `usage`: ~~common~~ obsolete",
+	} {
+		if !strings.Contains(buf.String(), s) {
+			t.Errorf("markdown is missing %q:\n%s", s, buf.String())
+		}
+	}
+
+	buf.Reset()
+	if err := c.WriteJSON(&buf); err != nil {
+		t.Fatal(err)
+	}
+	var decoded Changelog
+	if err := json.Unmarshal(buf.Bytes(), &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if len(decoded.Modified) != 1 || len(decoded.Modified[0].Changes) == 0 {
+		t.Fatalf("unexpected JSON round trip %+v", decoded)
+	}
+
+	feed := Feed{Title: "RAE changes", Link: "https://example.com/words/"}
+
+	buf.Reset()
+	if err := c.WriteRSS(&buf, feed); err != nil {
+		t.Fatal(err)
+	}
+	var r rss
+	if err := xml.Unmarshal(buf.Bytes(), &r); err != nil {
+		t.Fatal(err)
+	}
+	if len(r.Channel.Items) != 3 || r.Channel.Items[0].Link != "https://example.com/words/perro" {
+		t.Fatalf("unexpected RSS items %+v", r.Channel.Items)
+	}
+
+	buf.Reset()
+	if err := c.WriteAtom(&buf, feed); err != nil {
+		t.Fatal(err)
+	}
+	var a atomFeed
+	if err := xml.Unmarshal(buf.Bytes(), &a); err != nil {
+		t.Fatal(err)
+	}
+	if len(a.Entries) != 3 || a.Updated != "2026-10-01T00:00:00Z" || a.Author.Name != "RAE changes" {
+		t.Fatalf("unexpected Atom feed %+v", a)
+	}
+}
@@ -0,0 +1,185 @@
+package changelog
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// Feed describes the channel of the RSS and Atom outputs. Items link to
+// Link followed by the escaped headword. Author names the publisher in Atom
+// feeds, which require one, and defaults to Title.
+type Feed struct {
+	Title       string
+	Link        string
+	Description string
+	Author      string
+}
+
+func (f Feed) author() string {
+	if f.Author != "" {
+		return f.Author
+	}
+	return f.Title
+}
+
+type feedItem struct {
+	word    string
+	title   string
+	summary string
+}
+
+// items returns one item per added, removed or modified headword.
+func (c Changelog) items() []feedItem {
+	var items []feedItem
+
+	for _, w := range c.Added {
+		items = append(items, feedItem{w, w + " added", fmt.Sprintf("The headword %q was added.", w)})
+	}
+	for _, w := range c.Removed {
+		items = append(items, feedItem{w, w + " removed", fmt.Sprintf("The headword %q was removed.", w)})
+	}
+	for _, m := range c.Modified {
+		lines := make([]string, len(m.Changes))
+		for i, change := range m.Changes {
+			lines[i] = change.String()
+		}
+		items = append(items, feedItem{m.Word, m.Word + " modified", strings.Join(lines, "\n")})
+	}
+
+	return items
+}
+
+func (f Feed) itemLink(word string) string {
+	return strings.TrimSuffix(f.Link, "/") + "/" + url.PathEscape(word)
+}
+
+func (c Changelog) date() time.Time {
+	if c.Date.IsZero() {
+		return time.Now().UTC()
+	}
+	return c.Date.UTC()
+}
+
+type rss struct {
+	XMLName xml.Name   `xml:"rss"`
+	Version string     `xml:"version,attr"`
+	Channel rssChannel `xml:"channel"`
+}
+
+type rssChannel struct {
+	Title       string    `xml:"title"`
+	Link        string    `xml:"link"`
+	Description string    `xml:"description"`
+	PubDate     string    `xml:"pubDate"`
+	Items       []rssItem `xml:"item"`
+}
+
+type rssItem struct {
+	Title       string  `xml:"title"`
+	Link        string  `xml:"link"`
+	Description string  `xml:"description"`
+	GUID        rssGUID `xml:"guid"`
+	PubDate     string  `xml:"pubDate"`
+}
+
+type rssGUID struct {
+	IsPermaLink bool   `xml:"isPermaLink,attr"`
+	Value       string `xml:",chardata"`
+}
+
+// WriteRSS renders the changelog as an RSS 2.0 feed.
+func (c Changelog) WriteRSS(w io.Writer, feed Feed) error {
+	date := c.date().Format(time.RFC1123Z)
+
+	doc := rss{
+		Version: "2.0",
+		Channel: rssChannel{
+			Title:       feed.Title,
+			Link:        feed.Link,
+			Description: feed.Description,
+			PubDate:     date,
+		},
+	}
+
+	for _, item := range c.items() {
+		link := feed.itemLink(item.word)
+		doc.Channel.Items = append(doc.Channel.Items, rssItem{
+			Title:       item.title,
+			Link:        link,
+			Description: item.summary,
+			GUID:        rssGUID{Value: link + "#" + c.date().Format("20060102")},
+			PubDate:     date,
+		})
+	}
+
+	return writeXML(w, doc)
+}
+
+type atomFeed struct {
+	XMLName xml.Name    `xml:"http://www.w3.org/2005/Atom feed"`
+	Title   string      `xml:"title"`
+	ID      string      `xml:"id"`
+	Link    atomLink    `xml:"link"`
+	Updated string      `xml:"updated"`
+	Author  atomAuthor  `xml:"author"`
+	Entries []atomEntry `xml:"entry"`
+}
+
+type atomLink struct {
+	Href string `xml:"href,attr"`
+}
+
+type atomAuthor struct {
+	Name string `xml:"name"`
+}
+
+type atomEntry struct {
+	Title   string   `xml:"title"`
+	ID      string   `xml:"id"`
+	Link    atomLink `xml:"link"`
+	Updated string   `xml:"updated"`
+	Summary string   `xml:"summary"`
+}
+
+// WriteAtom renders the changelog as an Atom feed.
+func (c Changelog) WriteAtom(w io.Writer, feed Feed) error {
+	updated := c.date().Format(time.RFC3339)
+
+	doc := atomFeed{
+		Title:   feed.Title,
+		ID:      feed.Link,
+		Link:    atomLink{Href: feed.Link},
+		Updated: updated,
+		Author:  atomAuthor{Name: feed.author()},
+	}
+
+	for _, item := range c.items() {
+		link := feed.itemLink(item.word)
+		doc.Entries = append(doc.Entries, atomEntry{
+			Title:   item.title,
+			ID:      link + "#" + c.date().Format("20060102"),
+			Link:    atomLink{Href: link},
+			Updated: updated,
+			Summary: item.summary,
+		})
+	}
+
+	return writeXML(w, doc)
+}
+
+func writeXML(w io.Writer, v any) error {
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	if err := enc.Encode(v); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
@@ -0,0 +1,92 @@
+package changelog
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	rae "github.com/rae-api-com/go-rae"
+)
+
+// WriteMarkdown renders the changelog as a Markdown document.
+func (c Changelog) WriteMarkdown(w io.Writer) error {
+	var b strings.Builder
+
+	b.WriteString("# Dictionary changelog\n\n")
+	if c.From != "" || c.To != "" {
+		fmt.Fprintf(&b, "Changes from `%s` to `%s`", c.From, c.To)
+		if !c.Date.IsZero() {
+			fmt.Fprintf(&b, ", %s", c.Date.Format("2006-01-02"))
+		}
+		b.WriteString(".\n\n")
+	}
+
+	fmt.Fprintf(
+		&b,
+		"%d added, %d removed, %d modified headwords.\n",
+		len(c.Added), len(c.Removed), len(c.Modified),
+	)
+
+	writeWords(&b, "Added headwords", c.Added)
+	writeWords(&b, "Removed headwords", c.Removed)
+
+	if len(c.Usage) > 0 {
+		b.WriteString("\n## Usage changes\n\n")
+		for _, u := range c.Usage {
+			fmt.Fprintf(
+				&b,
+				"- **%s** %d.%d: %s → %s\n",
+				u.Word, u.Meaning, u.Sense, usageName(u.Old), usageName(u.New),
+			)
+		}
+	}
+
+	if len(c.Modified) > 0 {
+		b.WriteString("\n## Modified headwords\n")
+		for _, m := range c.Modified {
+			fmt.Fprintf(&b, "\n### %s\n\n", m.Word)
+			for _, change := range m.Changes {
+				fmt.Fprintf(&b, "- %s\n", markdownChange(change))
+			}
+		}
+	}
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+func writeWords(b *strings.Builder, title string, words []string) {
+	if len(words) == 0 {
+		return
+	}
+	fmt.Fprintf(b, "\n## %s\n\n", title)
+	for _, w := range words {
+		fmt.Fprintf(b, "- %s\n", w)
+	}
+}
+
+func usageName(u rae.Usage) string {
+	if u == "" {
+		return "none"
+	}
+	return u.Name(rae.LocaleEnglish)
+}
+
+func markdownChange(c rae.Change) string {
+	location := fmt.Sprintf("meaning %d", c.Meaning)
+	if c.Sense > 0 {
+		location = fmt.Sprintf("sense %d.%d", c.Meaning, c.Sense)
+	}
+	if c.Field != "" {
+		location += " `" + c.Field + "`"
+	}
+
+	switch c.Kind {
+	case rae.ChangeAdded:
+		return fmt.Sprintf("Added %s: %s", location, c.New)
+	case rae.ChangeRemoved:
+		return fmt.Sprintf("Removed %s: ~~%s~~", location, c.Old)
+	default:
+		return fmt.Sprintf("Changed %s: ~~%s~~ %s", location, c.Old, c.New)
+	}
+}
@@ -4,22 +4,39 @@ import (
 	"context"
 	"fmt"
 	"net/http"
-	"net/url"
+	"reflect"
 	"time"
 
 	"github.com/pkg/errors"
 	"github.com/sonirico/withttp"
+
+	"github.com/rae-api-com/go-rae/collate"
+	"github.com/rae-api-com/go-rae/phonetic"
 )
 
 type Client struct {
-	timeout time.Duration
-	version string
+	timeout        time.Duration
+	version        string
+	referenceDepth int
+	onUnknownValue func(UnknownValue)
+	strict         *StrictMode
+	restoreAccents bool
+	accentIndex    *AccentIndex
+	suggester      Suggester
+	phonetic       *phonetic.Index
+	api            *withttp.Endpoint
+}
+
+// Suggester proposes headwords close to a misspelt word.
+type Suggester interface {
+	Suggest(word string) []string
 }
 
 func New(opts ...ClientOption) *Client {
 	cli := &Client{
 		timeout: 5 * time.Second,
 		version: "dev",
+		api:     raeApi,
 	}
 
 	for _, opt := range opts {
@@ -29,60 +46,139 @@ func New(opts ...ClientOption) *Client {
 	return cli
 }
 
+// Word looks up a headword. The word is trimmed, converted to NFC and
+// lowercased, so "Canción" and a decomposed "canción" find the same entry.
+// Empty or over-long words fail with an *InputError.
 func (c *Client) Word(ctx context.Context, word string) (WordEntry, error) {
 	ctx, cancel := context.WithTimeout(ctx, c.timeout)
 	defer cancel()
 
-	res, err := GetWord(ctx, c.version, word)
-
+	var (
+		entry WordEntry
+		err   error
+	)
+	if c.restoreAccents {
+		entry, err = c.lookupRestoringAccents(ctx, word)
+	} else {
+		entry, err = c.lookup(ctx, word)
+	}
 	if err != nil {
-		return WordEntry{
-			Word:        word,
-			Suggestions: res.Suggestions,
-		}, err
+		return entry, err
 	}
 
-	if !res.Ok {
-		return WordEntry{
-			Word:        word,
-			Suggestions: res.Suggestions,
-		}, errors.New("word not found")
+	if c.referenceDepth > 0 {
+		c.resolveReferences(
+			ctx,
+			&entry,
+			c.referenceDepth,
+			map[string]bool{},
+			map[string]*WordEntry{},
+		)
 	}
 
-	return res.Data, nil
+	return entry, nil
 }
 
-func (c *Client) Random(ctx context.Context) (string, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.timeout)
-	defer cancel()
+func (c *Client) lookup(ctx context.Context, word string) (WordEntry, error) {
+	normalized, err := normalizeWord(word)
+	if err != nil {
+		return WordEntry{Word: word}, err
+	}
+	word = normalized
 
-	res, err := GetRandom(ctx, c.version)
+	res, raw, err := getWord(ctx, c.api, c.version, word)
 
 	if err != nil {
-		return "", err
+		entry := WordEntry{Word: word}
+		if res != nil {
+			entry.Suggestions = res.Suggestions
+		}
+		c.suggest(&entry)
+		return entry, err
 	}
 
 	if !res.Ok {
-		return "", errors.New("word not found")
+		entry := WordEntry{
+			Word:        word,
+			Suggestions: res.Suggestions,
+		}
+		if c.strict != nil {
+			report := checkEnvelope(wordPath(word), raw, reflect.TypeOf(entry))
+			if err := c.strict.handle(report); err != nil {
+				return entry, err
+			}
+		}
+		c.suggest(&entry)
+		return entry, ErrWordNotFound
+	}
 
+	entry := res.Data
+	entry.Enrich()
+
+	c.reportUnknownValues(&entry)
+
+	if c.strict != nil {
+		report := checkEnvelope(wordPath(word), raw, reflect.TypeOf(entry))
+		report.UnknownValues = entry.UnknownValues()
+		if err := c.strict.handle(report); err != nil {
+			return entry, err
+		}
+	}
+
+	return entry, nil
+}
+
+// reportUnknownValues calls the unknown value hook, if any, for the enum
+// values of entry this package does not know.
+func (c *Client) reportUnknownValues(entry *WordEntry) {
+	if c.onUnknownValue == nil {
+		return
+	}
+	for _, u := range entry.UnknownValues() {
+		c.onUnknownValue(u)
+	}
+}
+
+// suggest fills the suggestions of a word not found from the local
+// suggester when the API gave none.
+func (c *Client) suggest(entry *WordEntry) {
+	if c.suggester != nil && len(entry.Suggestions) == 0 {
+		entry.Suggestions = c.suggester.Suggest(entry.Word)
 	}
-	return res.Data.Word, nil
+}
+
+func (c *Client) Random(ctx context.Context) (string, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	return c.single(ctx, "/random")
 }
 
 func (c *Client) Daily(ctx context.Context) (string, error) {
 	ctx, cancel := context.WithTimeout(ctx, c.timeout)
 	defer cancel()
 
-	res, err := GetDaily(ctx, c.version)
+	return c.single(ctx, "/daily")
+}
+
+func (c *Client) single(ctx context.Context, uri string) (string, error) {
+	res, raw, err := getSingle(ctx, c.api, c.version, uri)
 
 	if err != nil {
 		return "", err
 	}
 
 	if !res.Ok {
-		return "", errors.New("word not found")
+		return "", ErrWordNotFound
+	}
 
+	if c.strict != nil {
+		report := checkEnvelope(uri, raw, reflect.TypeOf(res.Data))
+		if err := c.strict.handle(report); err != nil {
+			return res.Data.Word, err
+		}
 	}
+
 	return res.Data.Word, nil
 }
 
@@ -90,15 +186,45 @@ func (c *Client) Search(ctx context.Context, terms string) ([]SearchResult, erro
 	ctx, cancel := context.WithTimeout(ctx, c.timeout)
 	defer cancel()
 
-	res, err := GetSearch(ctx, c.version, terms)
+	res, raw, err := getSearch(ctx, c.api, c.version, terms)
 
 	if err != nil {
 		return nil, err
 	}
 
+	// Decoding is memoised, so the hook, strict mode and the caller share
+	// one decode per result.
+	if c.onUnknownValue != nil {
+		for i := range res {
+			if entry, err := res[i].WordEntry(); err == nil {
+				c.reportUnknownValues(entry)
+			}
+		}
+	}
+
+	if c.strict != nil {
+		report := checkBody("/search", raw, reflect.TypeOf(res))
+		for i := range res {
+			checkRaw(res[i].Doc.Raw, reflect.TypeOf(WordEntry{}), fmt.Sprintf("[%d].doc.raw", i), &report)
+			if entry, err := res[i].WordEntry(); err == nil {
+				report.UnknownValues = append(report.UnknownValues, entry.UnknownValues()...)
+			}
+		}
+		if err := c.strict.handle(report); err != nil {
+			return res, err
+		}
+	}
+
 	return res, nil
 }
 
+// CacheKey returns the key under which the entry of word should be cached.
+// It uses the same normalisation as Client.Word. Accents are kept because
+// they tell headwords apart, e.g. "papa" and "papá".
+func CacheKey(word string) string {
+	return "words/" + collate.Fold(word)
+}
+
 type ApiResponse[T any] struct {
 	Ok          bool     `json:"ok"`
 	Data        T        `json:"data"`
@@ -117,16 +243,34 @@ func GetWord(
 	ctx context.Context,
 	version, word string,
 ) (*WordEntryResponse, error) {
+	res, _, err := getWord(ctx, raeApi, version, word)
+
+	return res, err
+}
+
+// getWord is GetWord also returning the raw response body, which strict
+// mode checks against the entity model.
+func getWord(
+	ctx context.Context,
+	api *withttp.Endpoint,
+	version, word string,
+) (*WordEntryResponse, []byte, error) {
+	word, err := normalizeWord(word)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	call := withttp.NewCall[*WordEntryResponse](withttp.Fasthttp()).
-		URI(fmt.Sprintf("/words/%s", word)).
+		URI(wordPath(word)).
 		Method(http.MethodGet).
 		Header("User-Agent", fmt.Sprintf("rae-api/%s See https://rae-api.com", version), false).
+		ReadBody().
 		ParseJSON().
 		ExpectedStatusCodes(http.StatusOK, http.StatusNotFound)
 
-	err := call.CallEndpoint(ctx, raeApi)
+	err = call.CallEndpoint(ctx, api)
 
-	return call.BodyParsed, err
+	return call.BodyParsed, call.BodyRaw, err
 }
 
 type WordSingle struct {
@@ -139,32 +283,36 @@ func GetDaily(
 	ctx context.Context,
 	version string,
 ) (*WordResponse, error) {
-	call := withttp.NewCall[*WordResponse](withttp.Fasthttp()).
-		URI("/daily").
-		Method(http.MethodGet).
-		Header("User-Agent", fmt.Sprintf("rae-api/%s See https://rae-api.com", version), false).
-		ParseJSON().
-		ExpectedStatusCodes(http.StatusOK)
+	res, _, err := getSingle(ctx, raeApi, version, "/daily")
 
-	err := call.CallEndpoint(ctx, raeApi)
-
-	return call.BodyParsed, err
+	return res, err
 }
 
 func GetRandom(
 	ctx context.Context,
 	version string,
 ) (*WordResponse, error) {
+	res, _, err := getSingle(ctx, raeApi, version, "/random")
+
+	return res, err
+}
+
+func getSingle(
+	ctx context.Context,
+	api *withttp.Endpoint,
+	version, uri string,
+) (*WordResponse, []byte, error) {
 	call := withttp.NewCall[*WordResponse](withttp.Fasthttp()).
-		URI("/random").
+		URI(uri).
 		Method(http.MethodGet).
 		Header("User-Agent", fmt.Sprintf("rae-api/%s See https://rae-api.com", version), false).
+		ReadBody().
 		ParseJSON().
 		ExpectedStatusCodes(http.StatusOK)
 
-	err := call.CallEndpoint(ctx, raeApi)
+	err := call.CallEndpoint(ctx, api)
 
-	return call.BodyParsed, err
+	return call.BodyParsed, call.BodyRaw, err
 }
 
 func GetSearch(
@@ -172,22 +320,43 @@ func GetSearch(
 	version string,
 	terms string,
 ) ([]SearchResult, error) {
-	terms = url.QueryEscape(terms)
+	res, _, err := getSearch(ctx, raeApi, version, terms)
+
+	return res, err
+}
 
+func getSearch(
+	ctx context.Context,
+	api *withttp.Endpoint,
+	version string,
+	terms string,
+) ([]SearchResult, []byte, error) {
+	terms, err := normalizeSearch(terms)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Query encodes the value, it must not be escaped beforehand
 	call := withttp.NewCall[[]SearchResult](withttp.Fasthttp()).
 		URI("/search").
 		Query("q", terms)
 
 	call.Method(http.MethodGet).
 		Header("User-Agent", fmt.Sprintf("rae-api/%s See https://rae-api.com", version), false).
+		ReadBody().
 		ParseJSON().
 		ExpectedStatusCodes(http.StatusOK)
 
-	err := call.CallEndpoint(ctx, raeApi)
+	err = call.CallEndpoint(ctx, api)
 
 	if err != nil {
-		return nil, errors.Wrapf(err, "failed to search for terms %s", terms)
+		return nil, nil, errors.Wrapf(err, "failed to search for terms %s", terms)
+	}
+
+	results := call.BodyParsed
+	for i := range results {
+		results[i].memo = &entryMemo{}
 	}
 
-	return call.BodyParsed, nil
+	return results, call.BodyRaw, nil
 }
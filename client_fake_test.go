@@ -0,0 +1,265 @@
+package rae_test
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	rae "github.com/rae-api-com/go-rae"
+	"github.com/rae-api-com/go-rae/phonetic"
+	"github.com/rae-api-com/go-rae/raetest"
+)
+
+func TestWordNormalizesInput(t *testing.T) {
+	server := raetest.NewServer()
+	defer server.Close()
+
+	cli := rae.New(rae.WithBaseURL(server.URL))
+
+	for _, word := range []string{"Camión", "camión", "  CAMIÓN "} {
+		entry, err := cli.Word(context.Background(), word)
+		if err != nil {
+			t.Errorf("%q: %v", word, err)
+			continue
+		}
+		if entry.Word != "camión" {
+			t.Errorf("%q: got entry %q", word, entry.Word)
+		}
+	}
+
+	if rae.CacheKey("Camión") != rae.CacheKey("camión") {
+		t.Error("cache keys should not depend on case or normalisation form")
+	}
+	if rae.CacheKey("papa") == rae.CacheKey("papá") {
+		t.Error("cache keys should keep accents")
+	}
+}
+
+func TestClientSearchPhonetic(t *testing.T) {
+	server := raetest.NewServer()
+	defer server.Close()
+
+	cli := rae.New(rae.WithBaseURL(server.URL), rae.WithPhoneticIndex(phonetic.NewIndex("casa", "camión", "hablar")))
+
+	results, err := cli.SearchPhonetic(context.Background(), "ablar")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(results) != 1 || results[0].Doc.Word != "hablar" || results[0].Score != 1 {
+		t.Fatalf("got %+v", results)
+	}
+
+	// without an index, the hits of the API are ranked
+	cli = rae.New(rae.WithBaseURL(server.URL))
+
+	results, err = cli.SearchPhonetic(context.Background(), "casa")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(results) == 0 || results[0].Doc.Word != "casa" || results[0].Score != 1 {
+		t.Fatalf("got %+v", results)
+	}
+	entry, err := results[0].WordEntry()
+	if err != nil || entry.Word != "casa" {
+		t.Fatalf("WordEntry: %v, %v", entry, err)
+	}
+
+	// the API matches substrings, so misspellings need an index
+	results, err = cli.SearchPhonetic(context.Background(), "kaza")
+	if err != nil || len(results) != 0 {
+		t.Fatalf("kaza without an index: got %+v, %v", results, err)
+	}
+
+	if _, err := cli.SearchPhonetic(context.Background(), " "); err == nil {
+		t.Error("empty input should fail")
+	}
+}
+
+// referring returns an entry whose only definition points to targets.
+func referring(word string, targets ...string) rae.WordEntry {
+	description := "Sin definición."
+	if len(targets) > 0 {
+		description = "V. " + strings.Join(targets, ", ") + "."
+	}
+	return describing(word, description)
+}
+
+// describing returns an entry with a single noun sense.
+func describing(word, description string) rae.WordEntry {
+	return rae.WordEntry{
+		Word: word,
+		Meanings: []rae.Meaning{{
+			Definitions: []rae.Definition{{
+				Raw:           "1. m. " + description,
+				MeaningNumber: 1,
+				Category:      rae.CategoryNoun,
+				Description:   description,
+			}},
+		}},
+	}
+}
+
+func TestWordResolvesReferenceCycle(t *testing.T) {
+	server := raetest.NewServer(referring("ida", "vuelta"), referring("vuelta", "ida"))
+	defer server.Close()
+
+	cli := rae.New(rae.WithBaseURL(server.URL), rae.WithReferenceDepth(5))
+
+	entry, err := cli.Word(context.Background(), "ida")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ref := entry.Meanings[0].Definitions[0].References[0]
+	if ref.Entry == nil || ref.Entry.Word != "vuelta" {
+		t.Fatalf("expected vuelta to be resolved, got %+v", ref)
+	}
+	if back := ref.Entry.Meanings[0].Definitions[0].References[0]; back.Target != "ida" || back.Entry != nil {
+		t.Errorf("expected the reference back to ida to be left unresolved, got %+v", back)
+	}
+
+	if n := server.Lookups("ida"); n != 1 {
+		t.Errorf("expected ida to be requested once, got %d", n)
+	}
+	if n := server.Lookups("vuelta"); n != 1 {
+		t.Errorf("expected vuelta to be requested once, got %d", n)
+	}
+}
+
+func TestWordResolvesCapitalisedReferenceCycle(t *testing.T) {
+	server := raetest.NewServer(
+		describing("ida", "V. Vuelta."),
+		describing("vuelta", "V. Ida. Cf. Vuelta."),
+	)
+	defer server.Close()
+
+	cli := rae.New(rae.WithBaseURL(server.URL), rae.WithReferenceDepth(5))
+
+	entry, err := cli.Word(context.Background(), "ida")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	vuelta := entry.Meanings[0].Definitions[0].References[0].Entry
+	if vuelta == nil || vuelta.Word != "vuelta" {
+		t.Fatalf("expected vuelta to be resolved, got %+v", entry.Meanings[0].Definitions[0].References)
+	}
+	for _, ref := range vuelta.Meanings[0].Definitions[0].References {
+		if ref.Entry != nil {
+			t.Errorf("expected %q to be left unresolved as a cycle", ref.Target)
+		}
+	}
+
+	if _, err := json.Marshal(entry); err != nil {
+		t.Fatal(err)
+	}
+
+	if n := server.Lookups("ida") + server.Lookups("vuelta"); n != 2 {
+		t.Errorf("expected each word to be requested once, got %d requests", n)
+	}
+}
+
+func TestWordResolvesReferencesUpToDepth(t *testing.T) {
+	server := raetest.NewServer(referring("uno", "dos"), referring("dos", "tres"), referring("tres"))
+	defer server.Close()
+
+	cli := rae.New(rae.WithBaseURL(server.URL), rae.WithReferenceDepth(1))
+
+	entry, err := cli.Word(context.Background(), "uno")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ref := entry.Meanings[0].Definitions[0].References[0]
+	if ref.Entry == nil || ref.Entry.Word != "dos" {
+		t.Fatalf("expected dos to be resolved, got %+v", ref)
+	}
+	if next := ref.Entry.Meanings[0].Definitions[0].References[0]; next.Target != "tres" || next.Entry != nil {
+		t.Errorf("expected tres to be beyond the depth, got %+v", next)
+	}
+	if n := server.Lookups("tres"); n != 0 {
+		t.Errorf("expected tres not to be requested, got %d requests", n)
+	}
+}
+
+func TestWordSkipsMissingReference(t *testing.T) {
+	server := raetest.NewServer(referring("uno", "nada"))
+	defer server.Close()
+
+	cli := rae.New(rae.WithBaseURL(server.URL), rae.WithReferenceDepth(2))
+
+	entry, err := cli.Word(context.Background(), "uno")
+	if err != nil {
+		t.Fatalf("a missing target should not fail the lookup: %v", err)
+	}
+
+	ref := entry.Meanings[0].Definitions[0].References[0]
+	if ref.Target != "nada" || ref.Entry != nil {
+		t.Errorf("expected an unresolved reference to nada, got %+v", ref)
+	}
+	if n := server.Lookups("nada"); n != 1 {
+		t.Errorf("expected nada to be requested once, got %d", n)
+	}
+}
+
+func TestClientLocutionTriesLemmas(t *testing.T) {
+	server := raetest.NewServer(rae.WordEntry{
+		Word: "bueno",
+		Meanings: []rae.Meaning{{
+			Definitions: []rae.Definition{
+				{Raw: "1. adj. Que tiene bondad.", MeaningNumber: 1, Description: "Que tiene bondad."},
+				{
+					Raw:           "a la buena de Dios. 1. loc. adv. coloq. Sin artificio ni malicia.",
+					MeaningNumber: 1,
+					Description:   "Sin artificio ni malicia.",
+				},
+			},
+		}},
+	})
+	defer server.Close()
+
+	cli := rae.New(rae.WithBaseURL(server.URL))
+
+	_, locution, err := cli.Locution(context.Background(), "a la buena de Dios")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if locution.Definition != "Sin artificio ni malicia." {
+		t.Errorf("got %+v", locution)
+	}
+	if n := server.Lookups("buena"); n != 0 {
+		t.Errorf("expected the lemma to be tried before the inflected form, got %d requests for buena", n)
+	}
+}
+
+func TestSearchReportsUnknownValues(t *testing.T) {
+	server := raetest.NewServer(rae.WordEntry{
+		Word: "trebejo",
+		Meanings: []rae.Meaning{{
+			Definitions: []rae.Definition{{
+				Raw:           "1. m. Utensilio.",
+				MeaningNumber: 1,
+				Category:      "gadget",
+				Description:   "Utensilio.",
+			}},
+		}},
+	})
+	defer server.Close()
+
+	var unknown []rae.UnknownValue
+	cli := rae.New(rae.WithBaseURL(server.URL), rae.WithUnknownValueHook(func(u rae.UnknownValue) {
+		unknown = append(unknown, u)
+	}))
+
+	results, err := cli.Search(context.Background(), "trebejo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(results) != 1 {
+		t.Fatalf("expected one result, got %d", len(results))
+	}
+	if len(unknown) != 1 || unknown[0].Value != "gadget" {
+		t.Errorf("expected the unknown category to be reported, got %+v", unknown)
+	}
+}
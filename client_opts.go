@@ -1,6 +1,12 @@
 package rae
 
-import "time"
+import (
+	"time"
+
+	"github.com/sonirico/withttp"
+
+	"github.com/rae-api-com/go-rae/phonetic"
+)
 
 type ClientOption func(*Client)
 
@@ -15,3 +21,68 @@ func WithVersion(version string) ClientOption {
 		c.version = version
 	}
 }
+
+// WithReferenceDepth makes Word follow the cross-references of the returned
+// definitions up to depth levels and attach the referenced entries inline.
+func WithReferenceDepth(depth int) ClientOption {
+	return func(c *Client) {
+		c.referenceDepth = depth
+	}
+}
+
+// WithUnknownValueHook registers fn to be called for every enum value in a
+// response that this package does not know about, so API drift is noticed.
+// It covers the entries returned by Word and the results of Search. Entries
+// decoded without a client, by SearchResult.WordEntry on other results or by
+// DecodeAll, are not reported; check them with WordEntry.UnknownValues.
+func WithUnknownValueHook(fn func(UnknownValue)) ClientOption {
+	return func(c *Client) {
+		c.onUnknownValue = fn
+	}
+}
+
+// WithStrictMode checks every response against the entity model and reports
+// unknown fields, missing required fields and unknown enum values.
+func WithStrictMode(mode StrictMode) ClientOption {
+	return func(c *Client) {
+		c.strict = &mode
+	}
+}
+
+// WithBaseURL points the client to another deployment of the API, such as a
+// mirror or a local fake server. It defaults to https://rae-api.com/api.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) {
+		c.api = withttp.NewEndpoint("RaeAPI").
+			Request(withttp.BaseURL(baseURL))
+	}
+}
+
+// WithAccentRestoration makes Word resolve input typed without accents,
+// such as "cancion", to the headword it stands for. Candidates are taken
+// from index, when given, and otherwise from the suggestions of the API.
+// When several headwords match, Word fails with an *AmbiguousWordError
+// listing them instead of guessing.
+func WithAccentRestoration(index *AccentIndex) ClientOption {
+	return func(c *Client) {
+		c.restoreAccents = true
+		c.accentIndex = index
+	}
+}
+
+// WithSuggester fills the suggestions of words the API does not know, or
+// cannot be reached for, when the API provides none. See package suggest
+// for an index over a local word list.
+func WithSuggester(s Suggester) ClientOption {
+	return func(c *Client) {
+		c.suggester = s
+	}
+}
+
+// WithPhoneticIndex makes SearchPhonetic match the headwords of index
+// locally instead of ranking the hits of the API search.
+func WithPhoneticIndex(index *phonetic.Index) ClientOption {
+	return func(c *Client) {
+		c.phonetic = index
+	}
+}
@@ -0,0 +1,78 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/rae-api-com/go-rae/changelog"
+	"github.com/rae-api-com/go-rae/snapshot"
+)
+
+func runChangelog(args []string) int {
+	fs := flag.NewFlagSet("changelog", flag.ExitOnError)
+	format := fs.String("format", "markdown", "output format: json, markdown, rss or atom")
+	date := fs.String("date", "", "date of the new snapshot, YYYY-MM-DD (default today)")
+	title := fs.String("title", "Diccionario de la lengua española: cambios", "feed title")
+	author := fs.String("author", "", "feed author, required by Atom (default the title)")
+	link := fs.String("link", "https://rae-api.com/api/words/", "feed link, headwords are appended to it")
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "usage: rae changelog [flags] old-snapshot new-snapshot")
+		fs.PrintDefaults()
+	}
+	fs.Parse(args)
+
+	if fs.NArg() != 2 {
+		fs.Usage()
+		return 2
+	}
+
+	old, err := snapshot.ReadFile(fs.Arg(0))
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "rae:", err)
+		return 2
+	}
+	new, err := snapshot.ReadFile(fs.Arg(1))
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "rae:", err)
+		return 2
+	}
+
+	c := changelog.Compare(old, new)
+	c.From, c.To = fs.Arg(0), fs.Arg(1)
+	c.Date = time.Now().UTC()
+	if *date != "" {
+		if c.Date, err = time.Parse("2006-01-02", *date); err != nil {
+			fmt.Fprintln(os.Stderr, "rae:", err)
+			return 2
+		}
+	}
+
+	feed := changelog.Feed{
+		Title:       *title,
+		Link:        *link,
+		Description: fmt.Sprintf("Changes from %s to %s", c.From, c.To),
+		Author:      *author,
+	}
+
+	switch *format {
+	case "json":
+		err = c.WriteJSON(os.Stdout)
+	case "markdown", "md":
+		err = c.WriteMarkdown(os.Stdout)
+	case "rss":
+		err = c.WriteRSS(os.Stdout, feed)
+	case "atom":
+		err = c.WriteAtom(os.Stdout, feed)
+	default:
+		fmt.Fprintf(os.Stderr, "rae: unknown format %q\n", *format)
+		return 2
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "rae:", err)
+		return 2
+	}
+
+	return 0
+}
@@ -0,0 +1,45 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/rae-api-com/go-rae/conformance"
+)
+
+func runConformance(args []string) int {
+	fs := flag.NewFlagSet("conformance", flag.ExitOnError)
+
+	var cfg conformance.Config
+	fs.StringVar(&cfg.BaseURL, "base-url", "https://rae-api.com/api", "base URL of the deployment")
+	fs.StringVar(&cfg.Word, "word", "hablar", "an existing headword")
+	fs.StringVar(&cfg.MissingWord, "missing-word", "hablarx", "a headword the API does not know")
+	fs.StringVar(&cfg.Search, "search", "casa", "search terms with at least one result")
+	fs.DurationVar(&cfg.Timeout, "timeout", 10*time.Second, "timeout of each request")
+	asJSON := fs.Bool("json", false, "print the report as JSON")
+	fs.Parse(args)
+
+	report := conformance.Run(context.Background(), cfg)
+
+	var err error
+	if *asJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		err = enc.Encode(report)
+	} else {
+		err = report.WriteText(os.Stdout)
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "rae:", err)
+		return 2
+	}
+
+	if !report.Passed() {
+		return 1
+	}
+	return 0
+}
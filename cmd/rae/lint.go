@@ -0,0 +1,102 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/rae-api-com/go-rae/lint"
+	"github.com/rae-api-com/go-rae/snapshot"
+)
+
+func runLint(args []string) int {
+	fs := flag.NewFlagSet("lint", flag.ExitOnError)
+	asJSON := fs.Bool("json", false, "print findings as JSON Lines")
+	only := fs.String("rules", "", "comma separated rules to run (default all)")
+	minName := fs.String("severity", "info", "minimum severity reported")
+	failName := fs.String("fail-on", "error", "exit with status 1 on findings of this severity or higher")
+	list := fs.Bool("list", false, "list the rules and exit")
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "usage: rae lint [flags] snapshot...")
+		fs.PrintDefaults()
+	}
+	fs.Parse(args)
+
+	if *list {
+		for _, rule := range lint.Rules() {
+			fmt.Printf("%-24s %-8s %s\n", rule.Name, rule.Severity, rule.Description)
+		}
+		return 0
+	}
+
+	minSeverity, err := lint.ParseSeverity(*minName)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "rae:", err)
+		return 2
+	}
+	failSeverity, err := lint.ParseSeverity(*failName)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "rae:", err)
+		return 2
+	}
+
+	rules := lint.Rules()
+	if *only != "" {
+		rules = rules[:0]
+		for _, name := range strings.Split(*only, ",") {
+			rule, ok := lint.Lookup(strings.TrimSpace(name))
+			if !ok {
+				fmt.Fprintf(os.Stderr, "rae: unknown rule %q\n", name)
+				return 2
+			}
+			rules = append(rules, rule)
+		}
+	}
+
+	if fs.NArg() == 0 {
+		fs.Usage()
+		return 2
+	}
+
+	enc := json.NewEncoder(os.Stdout)
+	status := 0
+	counts := map[lint.Severity]int{}
+
+	for _, path := range fs.Args() {
+		entries, err := snapshot.ReadFile(path)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, "rae:", err)
+			return 2
+		}
+
+		for _, entry := range entries {
+			for _, f := range lint.Run(entry, rules...) {
+				if f.Severity < minSeverity {
+					continue
+				}
+				counts[f.Severity]++
+				if f.Severity >= failSeverity {
+					status = 1
+				}
+
+				if *asJSON {
+					enc.Encode(f)
+				} else {
+					fmt.Printf("%s: %s\n", path, f)
+				}
+			}
+		}
+	}
+
+	if !*asJSON {
+		fmt.Fprintf(
+			os.Stderr,
+			"%d errors, %d warnings, %d infos\n",
+			counts[lint.Error], counts[lint.Warning], counts[lint.Info],
+		)
+	}
+
+	return status
+}
@@ -0,0 +1,53 @@
+// Command rae is a command line companion of the go-rae client.
+package main
+
+import (
+	"fmt"
+	"os"
+	"sort"
+)
+
+type command struct {
+	summary string
+	run     func(args []string) int
+}
+
+var commands = map[string]command{
+	"changelog":   {"compare two snapshots and print what changed", runChangelog},
+	"conformance": {"check that a deployment behaves like rae-api.com", runConformance},
+	"lint":        {"check the entries of snapshots for data-quality problems", runLint},
+	"query":       {"print the senses of words matching a query", runQuery},
+	"reverse":     {"find the words of a snapshot by their definition", runReverse},
+}
+
+func usage() {
+	fmt.Fprintln(os.Stderr, "usage: rae <command> [flags]")
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "commands:")
+
+	names := make([]string, 0, len(commands))
+	for name := range commands {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		fmt.Fprintf(os.Stderr, "  %-14s %s\n", name, commands[name].summary)
+	}
+}
+
+func main() {
+	if len(os.Args) < 2 {
+		usage()
+		os.Exit(2)
+	}
+
+	cmd, ok := commands[os.Args[1]]
+	if !ok {
+		fmt.Fprintf(os.Stderr, "rae: unknown command %q\n\n", os.Args[1])
+		usage()
+		os.Exit(2)
+	}
+
+	os.Exit(cmd.run(os.Args[2:]))
+}
@@ -0,0 +1,100 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"slices"
+	"time"
+
+	rae "github.com/rae-api-com/go-rae"
+	"github.com/rae-api-com/go-rae/snapshot"
+)
+
+func runQuery(args []string) int {
+	fs := flag.NewFlagSet("query", flag.ExitOnError)
+	snapshotPath := fs.String("snapshot", "", "query the entries of a snapshot instead of the API")
+	baseURL := fs.String("base-url", "", "base URL of the API (default rae-api.com)")
+	timeout := fs.Duration("timeout", 5*time.Second, "timeout of each request")
+	asJSON := fs.Bool("json", false, "print senses as JSON Lines")
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), `usage: rae query [flags] "category:verb usage:!obsolete" word...`)
+		fs.PrintDefaults()
+	}
+	fs.Parse(args)
+
+	if fs.NArg() < 1 || (fs.NArg() < 2 && *snapshotPath == "") {
+		fs.Usage()
+		return 2
+	}
+
+	query, err := rae.ParseQuery(fs.Arg(0))
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "rae:", err)
+		return 2
+	}
+	words := fs.Args()[1:]
+
+	var entries []rae.WordEntry
+	if *snapshotPath != "" {
+		all, err := snapshot.ReadFile(*snapshotPath)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, "rae:", err)
+			return 2
+		}
+		for _, e := range all {
+			if len(words) == 0 || slices.Contains(words, e.Word) {
+				e.Enrich()
+				entries = append(entries, e)
+			}
+		}
+	} else {
+		opts := []rae.ClientOption{rae.WithTimeout(*timeout)}
+		if *baseURL != "" {
+			opts = append(opts, rae.WithBaseURL(*baseURL))
+		}
+		cli := rae.New(opts...)
+
+		for _, word := range words {
+			entry, err := cli.Word(context.Background(), word)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "rae: %s: %v\n", word, err)
+				continue
+			}
+			entries = append(entries, entry)
+		}
+	}
+
+	enc := json.NewEncoder(os.Stdout)
+	found := false
+
+	for _, entry := range entries {
+		for s := range query.Apply(entry.Senses()).All() {
+			found = true
+			if *asJSON {
+				enc.Encode(struct {
+					Word      string         `json:"word"`
+					Homograph int            `json:"homograph,omitempty"`
+					Sense     rae.Definition `json:"sense"`
+				}{entry.Word, s.Meaning.Homograph, s.Definition})
+				continue
+			}
+
+			word := entry.Word
+			if s.Meaning.Homograph > 0 {
+				word = fmt.Sprintf("%s (%d)", word, s.Meaning.Homograph)
+			}
+			fmt.Printf(
+				"%s %d. [%s] %s\n",
+				word, s.Definition.MeaningNumber, s.Definition.Category, s.Definition.Description,
+			)
+		}
+	}
+
+	if !found {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,64 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/rae-api-com/go-rae/reverse"
+	"github.com/rae-api-com/go-rae/snapshot"
+)
+
+func runReverse(args []string) int {
+	fs := flag.NewFlagSet("reverse", flag.ExitOnError)
+	snapshotPath := fs.String("snapshot", "", "snapshot whose entries are searched (required)")
+	limit := fs.Int("n", 10, "number of words to print")
+	asJSON := fs.Bool("json", false, "print candidates as JSON Lines")
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), `usage: rae reverse -snapshot file [flags] "miedo a los espacios cerrados"`)
+		fs.PrintDefaults()
+	}
+	fs.Parse(args)
+
+	if fs.NArg() < 1 || *snapshotPath == "" {
+		fs.Usage()
+		return 2
+	}
+
+	entries, err := snapshot.ReadFile(*snapshotPath)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "rae:", err)
+		return 2
+	}
+
+	idx := reverse.NewIndex(entries...)
+	idx.Limit = *limit
+
+	candidates, err := idx.ReverseLookup(context.Background(), strings.Join(fs.Args(), " "))
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "rae:", err)
+		return 2
+	}
+
+	enc := json.NewEncoder(os.Stdout)
+	for _, c := range candidates {
+		if *asJSON {
+			enc.Encode(struct {
+				Word        string  `json:"word"`
+				Sense       int     `json:"sense"`
+				Description string  `json:"description"`
+				Score       float64 `json:"score"`
+			}{c.Entry.Word, c.Sense.Definition.MeaningNumber, c.Sense.Definition.Description, c.Score})
+			continue
+		}
+		fmt.Printf("%.2f %s %d. %s\n", c.Score, c.Entry.Word, c.Sense.Definition.MeaningNumber, c.Highlight("*", "*"))
+	}
+
+	if len(candidates) == 0 {
+		return 1
+	}
+	return 0
+}
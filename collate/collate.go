@@ -0,0 +1,129 @@
+package collate
+
+import (
+	"slices"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Collator compares words in Spanish alphabetical order: ñ sorts after n
+// and accents and case are ignored unless the words are otherwise equal, in
+// which case unaccented and lowercase forms come first. With Traditional
+// set, ch and ll are letters of their own sorted after c and l, as in the
+// dictionaries before 1994.
+type Collator struct {
+	Traditional bool
+}
+
+// Default is the modern collator.
+var Default = Collator{}
+
+// Compare returns -1, 0 or 1 depending on whether a sorts before, equal to
+// or after b.
+func (c Collator) Compare(a, b string) int {
+	ka, kb := c.key(a), c.key(b)
+
+	if n := slices.Compare(ka.primary, kb.primary); n != 0 {
+		return n
+	}
+	if n := slices.Compare(ka.accents, kb.accents); n != 0 {
+		return n
+	}
+	if n := slices.Compare(ka.cases, kb.cases); n != 0 {
+		return n
+	}
+	return strings.Compare(a, b)
+}
+
+func (c Collator) Less(a, b string) bool {
+	return c.Compare(a, b) < 0
+}
+
+// Sort sorts words in place.
+func (c Collator) Sort(words []string) {
+	slices.SortStableFunc(words, c.Compare)
+}
+
+// Compare compares a and b with the Default collator.
+func Compare(a, b string) int {
+	return Default.Compare(a, b)
+}
+
+// Sort sorts words with the Default collator.
+func Sort(words []string) {
+	Default.Sort(words)
+}
+
+const (
+	digitWeight  = 10
+	letterWeight = 100
+	otherWeight  = 10000
+)
+
+// letterWeights follow the Spanish alphabet, leaving room after c and l for
+// the traditional ch and ll.
+var letterWeights = func() map[rune]int {
+	weights := map[rune]int{}
+	w := letterWeight
+	for _, r := range "abcdefghijklmnñopqrstuvwxyz" {
+		weights[r] = w
+		w += 10
+	}
+	return weights
+}()
+
+// digraphs are the second letters of ch and ll in traditional order.
+var digraphs = map[rune]rune{'c': 'h', 'l': 'l'}
+
+type sortKey struct {
+	primary []int
+	accents []int
+	cases   []int
+}
+
+func (c Collator) key(s string) sortKey {
+	var k sortKey
+
+	s = Normalize(s)
+	for i := 0; i < len(s); {
+		r, size := utf8.DecodeRuneInString(s[i:])
+		i += size
+
+		lower := unicode.ToLower(r)
+		base := baseRune(lower)
+
+		var weight int
+		switch {
+		case letterWeights[base] > 0:
+			weight = letterWeights[base]
+			if second, ok := digraphs[base]; ok && c.Traditional && i < len(s) {
+				next, nsize := utf8.DecodeRuneInString(s[i:])
+				if unicode.ToLower(next) == second {
+					weight += 5
+					i += nsize
+				}
+			}
+		case base >= '0' && base <= '9':
+			weight = digitWeight + int(base-'0')
+		case unicode.IsLetter(base):
+			weight = otherWeight + int(base)
+		default:
+			// spaces, hyphens and other punctuation are ignored
+			continue
+		}
+
+		k.primary = append(k.primary, weight)
+		k.accents = append(k.accents, boolInt(base != lower))
+		k.cases = append(k.cases, boolInt(lower != r))
+	}
+
+	return k
+}
+
+func boolInt(b bool) int {
+	if b {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,61 @@
+package collate
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestSort(t *testing.T) {
+	words := []string{"zorro", "ñandú", "ábaco", "nube", "Abeja", "oso", "abaco", "llama", "luz", "chico", "cuna"}
+
+	Sort(words)
+	want := []string{"abaco", "ábaco", "Abeja", "chico", "cuna", "llama", "luz", "nube", "ñandú", "oso", "zorro"}
+	if !slices.Equal(words, want) {
+		t.Errorf("modern order:\n got %v\nwant %v", words, want)
+	}
+
+	Collator{Traditional: true}.Sort(words)
+	want = []string{"abaco", "ábaco", "Abeja", "cuna", "chico", "luz", "llama", "nube", "ñandú", "oso", "zorro"}
+	if !slices.Equal(words, want) {
+		t.Errorf("traditional order:\n got %v\nwant %v", words, want)
+	}
+}
+
+func TestCompare(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"papa", "papá", -1},
+		{"papá", "papa", 1},
+		{"casa", "Casa", -1},
+		{"casa", "casa", 0},
+		{"cana", "caña", -1},
+		{"caña", "canoa", 1},
+		{"pingüino", "pinguino", 1},
+		{"a posteriori", "apóstol", -1},
+	}
+
+	for _, tt := range tests {
+		if got := Compare(tt.a, tt.b); got != tt.want {
+			t.Errorf("Compare(%q, %q) = %d, want %d", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestNormalize(t *testing.T) {
+	decomposed := "cancio\u0301n"
+
+	if got := Normalize("  canción  "); got != "canción" {
+		t.Errorf("Normalize: got %q", got)
+	}
+	if got := Fold("Canción"); got != Fold(decomposed) || got != "canción" {
+		t.Errorf("Fold: got %q", got)
+	}
+	if got := StripAccents("Pingüino, ÁRBOL y ñandú"); got != "Pinguino, ARBOL y ñandu" {
+		t.Errorf("StripAccents: got %q", got)
+	}
+	if !Equal("Canción", "cancion") || Equal("caña", "cana") {
+		t.Error("Equal should ignore accents and case but not ñ")
+	}
+}
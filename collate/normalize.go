@@ -0,0 +1,67 @@
+// Package collate sorts and compares Spanish words the way dictionaries do
+// and provides the normalisation used for lookups, cache keys and search.
+package collate
+
+import (
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/cases"
+	"golang.org/x/text/unicode/norm"
+)
+
+var folder = cases.Fold()
+
+// Normalize trims s, collapses inner whitespace and converts it to NFC, so
+// that "canción" and "canción" are the same string.
+func Normalize(s string) string {
+	return norm.NFC.String(strings.Join(strings.Fields(s), " "))
+}
+
+// Fold normalises s and folds its case. Accents are kept, since they tell
+// headwords such as "papa" and "papá" apart.
+func Fold(s string) string {
+	return norm.NFC.String(folder.String(Normalize(s)))
+}
+
+// StripAccents removes the acute accents and the diaeresis of s. The tilde
+// of ñ is kept, as ñ is a letter of its own.
+func StripAccents(s string) string {
+	var b strings.Builder
+	b.Grow(len(s))
+
+	for _, r := range norm.NFC.String(s) {
+		b.WriteRune(baseRune(r))
+	}
+
+	return b.String()
+}
+
+// baseRune returns r without its diacritics, except for ñ and Ñ.
+func baseRune(r rune) rune {
+	if r < 0x80 || r == 'ñ' || r == 'Ñ' {
+		return r
+	}
+
+	base := r
+	for _, d := range norm.NFD.String(string(r)) {
+		if unicode.Is(unicode.Mn, d) {
+			continue
+		}
+		base = d
+		break
+	}
+
+	return base
+}
+
+// Key is the accent and case insensitive form of s, used to match user
+// input such as "Cancion" against "canción".
+func Key(s string) string {
+	return StripAccents(Fold(s))
+}
+
+// Equal reports whether a and b have the same Key.
+func Equal(a, b string) bool {
+	return Key(a) == Key(b)
+}
@@ -0,0 +1,372 @@
+// Package conformance verifies that a deployment of the API behaves like
+// rae-api.com: status codes, the response envelope and the invariants of the
+// entities, both on the wire and once decoded by the client.
+package conformance
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	rae "github.com/rae-api-com/go-rae"
+)
+
+// Config selects the deployment and the words used by the checks.
+type Config struct {
+	BaseURL     string
+	Word        string // an existing headword
+	MissingWord string // a headword the API does not know
+	Search      string // terms with at least one result
+	Timeout     time.Duration
+	HTTPClient  *http.Client
+}
+
+func (c Config) withDefaults() Config {
+	if c.Word == "" {
+		c.Word = "hablar"
+	}
+	if c.MissingWord == "" {
+		c.MissingWord = "hablarx"
+	}
+	if c.Search == "" {
+		c.Search = "casa"
+	}
+	if c.Timeout == 0 {
+		c.Timeout = 10 * time.Second
+	}
+	if c.HTTPClient == nil {
+		c.HTTPClient = &http.Client{Timeout: c.Timeout}
+	}
+	return c
+}
+
+// Check is the outcome of a single conformance check.
+type Check struct {
+	Name     string   `json:"name"`
+	Endpoint string   `json:"endpoint"`
+	Failures []string `json:"failures,omitempty"`
+}
+
+func (c Check) Passed() bool {
+	return len(c.Failures) == 0
+}
+
+func (c *Check) failf(format string, args ...any) {
+	c.Failures = append(c.Failures, fmt.Sprintf(format, args...))
+}
+
+// Report gathers the checks of a run.
+type Report struct {
+	BaseURL string  `json:"base_url"`
+	Checks  []Check `json:"checks"`
+}
+
+func (r Report) Passed() bool {
+	for _, c := range r.Checks {
+		if !c.Passed() {
+			return false
+		}
+	}
+	return true
+}
+
+// WriteText prints one PASS/FAIL line per check followed by its failures.
+func (r Report) WriteText(w io.Writer) error {
+	passed := 0
+	for _, c := range r.Checks {
+		status := "PASS"
+		if c.Passed() {
+			passed++
+		} else {
+			status = "FAIL"
+		}
+		if _, err := fmt.Fprintf(w, "%s  %-20s %s\n", status, c.Name, c.Endpoint); err != nil {
+			return err
+		}
+		for _, f := range c.Failures {
+			if _, err := fmt.Fprintf(w, "      - %s\n", f); err != nil {
+				return err
+			}
+		}
+	}
+	_, err := fmt.Fprintf(w, "\n%d/%d checks passed against %s\n", passed, len(r.Checks), r.BaseURL)
+	return err
+}
+
+type runner struct {
+	cfg    Config
+	client *rae.Client
+	drift  []rae.SchemaReport
+}
+
+// Run executes every check against cfg.BaseURL.
+func Run(ctx context.Context, cfg Config) Report {
+	cfg = cfg.withDefaults()
+
+	r := &runner{cfg: cfg}
+	r.client = rae.New(
+		rae.WithBaseURL(cfg.BaseURL),
+		rae.WithTimeout(cfg.Timeout),
+		rae.WithStrictMode(rae.StrictMode{
+			OnReport: func(report rae.SchemaReport) {
+				r.drift = append(r.drift, report)
+			},
+		}),
+	)
+
+	return Report{
+		BaseURL: cfg.BaseURL,
+		Checks: []Check{
+			r.checkWord(ctx),
+			r.checkMissingWord(ctx),
+			r.checkSearch(ctx),
+			r.checkSingle(ctx, "random", "/random", r.client.Random),
+			r.checkSingle(ctx, "daily", "/daily", r.client.Daily),
+		},
+	}
+}
+
+func (r *runner) checkWord(ctx context.Context) Check {
+	endpoint := "/words/" + url.PathEscape(r.cfg.Word)
+	check := Check{Name: "word", Endpoint: endpoint}
+
+	env, ok := r.envelope(ctx, &check, endpoint, http.StatusOK)
+	if ok && env.Ok != nil && !*env.Ok {
+		check.failf("ok is false for an existing word")
+	}
+
+	var entry rae.WordEntry
+	err := r.withDrift(&check, func() error {
+		var err error
+		entry, err = r.client.Word(ctx, r.cfg.Word)
+		return err
+	})
+	if err != nil {
+		check.failf("client: %v", err)
+		return check
+	}
+
+	checkEntry(&check, entry, r.cfg.Word)
+
+	return check
+}
+
+func (r *runner) checkMissingWord(ctx context.Context) Check {
+	endpoint := "/words/" + url.PathEscape(r.cfg.MissingWord)
+	check := Check{Name: "word not found", Endpoint: endpoint}
+
+	env, ok := r.envelope(ctx, &check, endpoint, http.StatusNotFound)
+	if ok && env.Ok != nil && *env.Ok {
+		check.failf("ok is true for a missing word")
+	}
+
+	if _, err := r.client.Word(ctx, r.cfg.MissingWord); err == nil {
+		check.failf("client: expected an error for a missing word")
+	}
+
+	return check
+}
+
+func (r *runner) checkSearch(ctx context.Context) Check {
+	endpoint := "/search?q=" + url.QueryEscape(r.cfg.Search)
+	check := Check{Name: "search", Endpoint: endpoint}
+
+	body, ok := r.get(ctx, &check, endpoint, http.StatusOK)
+	if ok {
+		var results []json.RawMessage
+		if err := json.Unmarshal(body, &results); err != nil {
+			check.failf("body is not a JSON array: %v", err)
+		}
+	}
+
+	var results []rae.SearchResult
+	err := r.withDrift(&check, func() error {
+		var err error
+		results, err = r.client.Search(ctx, r.cfg.Search)
+		return err
+	})
+	if err != nil {
+		check.failf("client: %v", err)
+		return check
+	}
+
+	if len(results) == 0 {
+		check.failf("no results for %q", r.cfg.Search)
+	}
+
+	for i, res := range results {
+		if res.Doc.Word == "" {
+			check.failf("results[%d]: empty doc id", i)
+		}
+		if res.Hits < 0 {
+			check.failf("results[%d]: negative hits %d", i, res.Hits)
+		}
+		entry, err := res.WordEntry()
+		if err != nil {
+			check.failf("results[%d]: doc raw does not decode: %v", i, err)
+			continue
+		}
+		if entry.Word != res.Doc.Word {
+			check.failf("results[%d]: doc id %q does not match entry %q", i, res.Doc.Word, entry.Word)
+		}
+	}
+
+	return check
+}
+
+func (r *runner) checkSingle(
+	ctx context.Context,
+	name, endpoint string,
+	call func(context.Context) (string, error),
+) Check {
+	check := Check{Name: name, Endpoint: endpoint}
+
+	env, ok := r.envelope(ctx, &check, endpoint, http.StatusOK)
+	if ok {
+		var single rae.WordSingle
+		if err := json.Unmarshal(env.Data, &single); err != nil || single.Word == "" {
+			check.failf("data.word is missing or empty")
+		}
+	}
+
+	var word string
+	err := r.withDrift(&check, func() error {
+		var err error
+		word, err = call(ctx)
+		return err
+	})
+	if err != nil {
+		check.failf("client: %v", err)
+	} else if word == "" {
+		check.failf("client: empty word")
+	}
+
+	return check
+}
+
+// withDrift runs fn and turns the schema reports it produced into failures.
+func (r *runner) withDrift(check *Check, fn func() error) error {
+	before := len(r.drift)
+	err := fn()
+	for _, report := range r.drift[before:] {
+		for _, f := range report.UnknownFields {
+			check.failf("unknown field %s", f)
+		}
+		for _, f := range report.MissingFields {
+			check.failf("missing field %s", f)
+		}
+		for _, v := range report.UnknownValues {
+			check.failf("%s", v)
+		}
+		if report.DecodeError != "" {
+			check.failf("decode: %s", report.DecodeError)
+		}
+	}
+	return err
+}
+
+type rawEnvelope struct {
+	Ok   *bool           `json:"ok"`
+	Data json.RawMessage `json:"data"`
+}
+
+// envelope fetches endpoint and checks the shape of the response envelope.
+func (r *runner) envelope(
+	ctx context.Context,
+	check *Check,
+	endpoint string,
+	status int,
+) (rawEnvelope, bool) {
+	var env rawEnvelope
+
+	body, ok := r.get(ctx, check, endpoint, status)
+	if !ok {
+		return env, false
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(body, &fields); err != nil {
+		check.failf("body is not a JSON object: %v", err)
+		return env, false
+	}
+	if err := json.Unmarshal(body, &env); err != nil {
+		check.failf("envelope: %v", err)
+		return env, false
+	}
+
+	if env.Ok == nil {
+		check.failf("envelope: missing ok")
+	}
+	if env.Ok != nil && *env.Ok && len(env.Data) == 0 {
+		check.failf("envelope: missing data")
+	}
+	if raw, ok := fields["suggestions"]; ok && string(raw) != "null" {
+		var suggestions []string
+		if err := json.Unmarshal(raw, &suggestions); err != nil {
+			check.failf("envelope: suggestions is not an array of strings")
+		}
+	}
+
+	return env, true
+}
+
+func (r *runner) get(ctx context.Context, check *Check, endpoint string, status int) ([]byte, bool) {
+	target := strings.TrimSuffix(r.cfg.BaseURL, "/") + endpoint
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, target, nil)
+	if err != nil {
+		check.failf("request: %v", err)
+		return nil, false
+	}
+
+	res, err := r.cfg.HTTPClient.Do(req)
+	if err != nil {
+		check.failf("request: %v", err)
+		return nil, false
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		check.failf("read body: %v", err)
+		return nil, false
+	}
+
+	if res.StatusCode != status {
+		check.failf("status: want %d, got %d", status, res.StatusCode)
+	}
+
+	return body, true
+}
+
+// checkEntry verifies the invariants of a decoded entry.
+func checkEntry(check *Check, entry rae.WordEntry, word string) {
+	if !strings.EqualFold(entry.Word, word) {
+		check.failf("entry word: want %q, got %q", word, entry.Word)
+	}
+	if len(entry.Meanings) == 0 {
+		check.failf("entry has no meanings")
+	}
+	for i, m := range entry.Meanings {
+		if len(m.Definitions) == 0 {
+			check.failf("meanings[%d]: no senses", i)
+		}
+		for j, d := range m.Definitions {
+			path := fmt.Sprintf("meanings[%d].senses[%d]", i, j)
+			if d.MeaningNumber <= 0 {
+				check.failf("%s: meaning_number %d is not positive", path, d.MeaningNumber)
+			}
+			if strings.TrimSpace(d.Description) == "" {
+				check.failf("%s: empty description", path)
+			}
+			if !d.Category.IsValid() {
+				check.failf("%s: invalid category %q", path, d.Category)
+			}
+		}
+	}
+}
@@ -0,0 +1,60 @@
+package conformance
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/rae-api-com/go-rae/raetest"
+)
+
+func TestRunFakeServer(t *testing.T) {
+	server := raetest.NewServer()
+	defer server.Close()
+
+	report := Run(context.Background(), Config{BaseURL: server.URL})
+
+	var out bytes.Buffer
+	if err := report.WriteText(&out); err != nil {
+		t.Fatal(err)
+	}
+
+	if !report.Passed() {
+		t.Fatalf("expected the fake server to conform:\n%s", out.String())
+	}
+	if len(report.Checks) != 5 {
+		t.Fatalf("expected 5 checks, got %d", len(report.Checks))
+	}
+	if !strings.Contains(out.String(), "5/5 checks passed") {
+		t.Fatalf("unexpected report:\n%s", out.String())
+	}
+}
+
+func TestRunNonConforming(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"ok":true,"data":{"word":"hablar","extra":1},"suggestions":"none"}`))
+	}))
+	defer server.Close()
+
+	report := Run(context.Background(), Config{BaseURL: server.URL})
+	if report.Passed() {
+		t.Fatal("expected the report to fail")
+	}
+
+	failed := map[string]bool{}
+	for _, c := range report.Checks {
+		if !c.Passed() {
+			failed[c.Name] = true
+		}
+	}
+
+	for _, name := range []string{"word", "word not found", "search", "random", "daily"} {
+		if !failed[name] {
+			t.Errorf("expected check %q to fail", name)
+		}
+	}
+}
@@ -0,0 +1,158 @@
+package rae
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"runtime"
+	"sync"
+	"sync/atomic"
+
+	"github.com/mailru/easyjson/jlexer"
+	"github.com/sonirico/vago/zero"
+)
+
+// decodeParallelMin is the number of results from which DecodeAll spreads
+// the work over several goroutines.
+const decodeParallelMin = 64
+
+// DecodeAll decodes the entries of results in parallel, in the same order.
+// It fails with the first error found, naming the headword of the result.
+func DecodeAll(ctx context.Context, results []SearchResult) ([]*WordEntry, error) {
+	entries := make([]*WordEntry, len(results))
+
+	workers := min(runtime.GOMAXPROCS(0), len(results)/decodeParallelMin+1)
+
+	var (
+		next     atomic.Int64
+		wg       sync.WaitGroup
+		errOnce  sync.Once
+		firstErr error
+		failed   atomic.Bool
+	)
+
+	for range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for !failed.Load() {
+				i := int(next.Add(1)) - 1
+				if i >= len(results) {
+					return
+				}
+
+				err := ctx.Err()
+				if err == nil {
+					entries[i], err = results[i].WordEntry()
+					if err != nil {
+						err = fmt.Errorf("decoding %q: %w", results[i].Doc.Word, err)
+					}
+				}
+				if err != nil {
+					errOnce.Do(func() { firstErr = err })
+					failed.Store(true)
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	return entries, nil
+}
+
+// Decoder decodes entries for bulk ingestion, such as rebuilding an index
+// from millions of payloads. It decodes into an entry given by the caller,
+// without copying the input, with the generated decoder, which interns
+// enumerated values and synonyms. The read buffer of DecodeLines is kept
+// from one stream to the next.
+//
+// A Decoder is not safe for concurrent use. AcquireDecoder and
+// ReleaseDecoder share decoders between goroutines.
+type Decoder struct {
+	// SkipEnrichment leaves out the fields derived from the raw
+	// definitions, such as scopes and references, which cost more than
+	// decoding itself. Call WordEntry.Enrich to add them later.
+	SkipEnrichment bool
+
+	buf []byte
+}
+
+func NewDecoder() *Decoder {
+	return &Decoder{}
+}
+
+var decoders = sync.Pool{
+	New: func() any { return NewDecoder() },
+}
+
+// AcquireDecoder returns a decoder from a pool. Return it with
+// ReleaseDecoder when done.
+func AcquireDecoder() *Decoder {
+	return decoders.Get().(*Decoder)
+}
+
+func ReleaseDecoder(d *Decoder) {
+	d.SkipEnrichment = false
+	decoders.Put(d)
+}
+
+// Decode decodes data into entry, replacing its contents.
+func (d *Decoder) Decode(data []byte, entry *WordEntry) error {
+	*entry = WordEntry{}
+
+	in := jlexer.Lexer{Data: data}
+	entry.UnmarshalEasyJSON(&in)
+	in.Consumed()
+	if err := in.Error(); err != nil {
+		return err
+	}
+
+	if !d.SkipEnrichment {
+		entry.Enrich()
+	}
+	return nil
+}
+
+// DecodeString is Decode for raw JSON held in a string, such as
+// Document.Raw, without copying it.
+func (d *Decoder) DecodeString(raw string, entry *WordEntry) error {
+	return d.Decode(zero.S2B(raw), entry)
+}
+
+// maxLine is the longest line DecodeLines accepts.
+const maxLine = 16 << 20
+
+// DecodeLines decodes a JSON Lines stream, such as a snapshot, calling fn
+// with every entry. All of them are decoded into entry, which fn must not
+// keep. The read buffer is kept by the decoder for the next stream.
+func (d *Decoder) DecodeLines(r io.Reader, entry *WordEntry, fn func(*WordEntry) error) error {
+	if d.buf == nil {
+		d.buf = make([]byte, 64<<10)
+	}
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(d.buf, maxLine)
+
+	line := 0
+	for scanner.Scan() {
+		line++
+		data := bytes.TrimSpace(scanner.Bytes())
+		if len(data) == 0 {
+			continue
+		}
+		if err := d.Decode(data, entry); err != nil {
+			return fmt.Errorf("line %d: %w", line, err)
+		}
+		if err := fn(entry); err != nil {
+			return err
+		}
+	}
+
+	return scanner.Err()
+}
@@ -0,0 +1,372 @@
+package rae_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+	"unsafe"
+
+	"github.com/mailru/easyjson"
+
+	rae "github.com/rae-api-com/go-rae"
+	"github.com/rae-api-com/go-rae/raetest"
+	"github.com/rae-api-com/go-rae/snapshot"
+)
+
+func searchResults(tb testing.TB, n int) []rae.SearchResult {
+	tb.Helper()
+
+	entries := raetest.Entries()
+	results := make([]rae.SearchResult, n)
+	for i := range results {
+		e := entries[i%len(entries)]
+		raw, err := json.Marshal(e)
+		if err != nil {
+			tb.Fatal(err)
+		}
+		results[i] = rae.SearchResult{
+			Doc:  rae.Document{Word: e.Word, Raw: string(raw)},
+			Hits: 1,
+		}
+	}
+	return results
+}
+
+func TestSearchResultWordEntry(t *testing.T) {
+	results := searchResults(t, 1)
+
+	first, err := results[0].WordEntry()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if first.Word != "hablar" || first.Meanings[0].Conjugations == nil {
+		t.Fatalf("decoded %+v", first)
+	}
+
+	// results built by hand decode a new entry on every call
+	first.Meanings[0].Definitions[0].Description = "cambiada"
+	second, _ := results[0].WordEntry()
+	if first == second || second.Meanings[0].Definitions[0].Description == "cambiada" {
+		t.Error("entries should not be shared between calls")
+	}
+
+	empty := rae.SearchResult{Doc: rae.Document{Word: "hablar"}}
+	if _, err := empty.WordEntry(); err == nil {
+		t.Error("a result without raw entry should fail")
+	}
+}
+
+func TestSearchWordEntryMemoised(t *testing.T) {
+	server := raetest.NewServer(raetest.Entries()...)
+	defer server.Close()
+
+	results, err := rae.New(rae.WithBaseURL(server.URL)).Search(context.Background(), "casa")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(results) == 0 {
+		t.Fatal("no results")
+	}
+
+	first, err := results[0].WordEntry()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	copied := results[0]
+	entries := make([]*rae.WordEntry, 8)
+	var wg sync.WaitGroup
+	for i := range entries {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			entries[i], _ = copied.WordEntry()
+		}()
+	}
+	wg.Wait()
+
+	for _, entry := range entries {
+		if entry != first {
+			t.Fatal("results returned by the client should decode their entry once")
+		}
+	}
+}
+
+func TestDecodeAll(t *testing.T) {
+	results := searchResults(t, 500)
+
+	entries, err := rae.DecodeAll(context.Background(), results)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, e := range entries {
+		if e == nil || e.Word != results[i].Doc.Word {
+			t.Fatalf("entry %d: got %v, want %q", i, e, results[i].Doc.Word)
+		}
+	}
+
+	results = searchResults(t, 300)
+	results[123].Doc = rae.Document{Word: "roto", Raw: `{"word":`}
+	if _, err := rae.DecodeAll(context.Background(), results); err == nil || !strings.Contains(err.Error(), `"roto"`) {
+		t.Errorf("got %v, want an error naming the broken result", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := rae.DecodeAll(ctx, searchResults(t, 10)); err == nil {
+		t.Error("cancelled context should fail")
+	}
+
+	if entries, err := rae.DecodeAll(context.Background(), nil); err != nil || len(entries) != 0 {
+		t.Errorf("no results: %v, %v", entries, err)
+	}
+}
+
+func BenchmarkDecodeAll(b *testing.B) {
+	for _, n := range []int{10, 1000} {
+		b.Run(fmt.Sprint(n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				b.StopTimer()
+				results := searchResults(b, n)
+				b.StartTimer()
+				rae.DecodeAll(context.Background(), results)
+			}
+		})
+	}
+}
+
+func mustWordEntry(t *testing.T, raw string) *rae.WordEntry {
+	t.Helper()
+	r := rae.SearchResult{Doc: rae.Document{Raw: raw}}
+	e, err := r.WordEntry()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return e
+}
+
+func TestDecodeInternsValues(t *testing.T) {
+	if raceEnabled {
+		t.Skip("the race detector drops sync.Pool items at random")
+	}
+
+	const raw = `{"word":"w","meanings":[{"senses":[{"meaning_number":1,"category":"noun","usage":"common","description":"d","synonyms":["cosa"],"antonyms":[]}]}]}`
+
+	da := mustWordEntry(t, raw).Meanings[0].Definitions[0]
+
+	// interning is best effort, its table lives in a sync.Pool
+	var category, synonym bool
+	for range 10 {
+		db := mustWordEntry(t, raw).Meanings[0].Definitions[0]
+		category = category || unsafe.StringData(string(da.Category)) == unsafe.StringData(string(db.Category))
+		synonym = synonym || unsafe.StringData(da.Synonyms[0]) == unsafe.StringData(db.Synonyms[0])
+	}
+
+	if !category {
+		t.Error("categories should be interned")
+	}
+	if !synonym {
+		t.Error("synonyms should be interned")
+	}
+}
+
+func TestDecoderMatchesGeneratedDecoder(t *testing.T) {
+	d := rae.AcquireDecoder()
+	defer rae.ReleaseDecoder(d)
+
+	var reused rae.WordEntry
+	for _, e := range append(raetest.Entries(), rae.WordEntry{Word: "cosa", Suggestions: []string{"casa", "cose"}}) {
+		raw, err := json.Marshal(e)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		want := *mustWordEntry(t, string(raw))
+
+		if err := d.Decode(raw, &reused); err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(reused, want) {
+			t.Errorf("%s: decoded into a reused entry\n got %+v\nwant %+v", e.Word, reused, want)
+		}
+	}
+
+	// nothing is left over from the previous entry
+	if err := d.DecodeString(`{"word":"x","meanings":[]}`, &reused); err != nil {
+		t.Fatal(err)
+	}
+	if reused.Word != "x" || reused.Suggestions != nil || len(reused.Meanings) != 0 || reused.Meanings == nil {
+		t.Errorf("got %+v", reused)
+	}
+
+	if err := d.DecodeString(`{"word":`, &reused); err == nil {
+		t.Error("truncated input should fail")
+	}
+}
+
+func TestDecoderSkipEnrichment(t *testing.T) {
+	const raw = `{"word":"guagua","meanings":[{"senses":[{"raw":"1. f. Ant. Autobús.","meaning_number":1,"category":"noun","description":"Autobús.","synonyms":[],"antonyms":[]}]}]}`
+
+	d := rae.NewDecoder()
+	d.SkipEnrichment = true
+
+	var entry rae.WordEntry
+	if err := d.DecodeString(raw, &entry); err != nil {
+		t.Fatal(err)
+	}
+	if entry.Meanings[0].Definitions[0].Regions != nil {
+		t.Error("enrichment should be skipped")
+	}
+
+	d.SkipEnrichment = false
+	if err := d.DecodeString(raw, &entry); err != nil {
+		t.Fatal(err)
+	}
+	if !slices.Contains(entry.Meanings[0].Definitions[0].Regions, rae.RegionAntilles) {
+		t.Errorf("expected the entry to be enriched, got %+v", entry.Meanings[0].Definitions[0])
+	}
+}
+
+func TestDecodeLines(t *testing.T) {
+	var buf bytes.Buffer
+	if err := snapshot.Write(&buf, raetest.Entries()); err != nil {
+		t.Fatal(err)
+	}
+	buf.WriteString("\n")
+
+	var (
+		entry rae.WordEntry
+		words []string
+	)
+	err := rae.NewDecoder().DecodeLines(&buf, &entry, func(e *rae.WordEntry) error {
+		words = append(words, e.Word)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !slices.Equal(words, []string{"hablar", "casa", "camión"}) {
+		t.Errorf("got %v", words)
+	}
+
+	err = rae.NewDecoder().DecodeLines(strings.NewReader("{\"word\":\"a\"}\n{\n"), &entry, func(*rae.WordEntry) error {
+		return nil
+	})
+	if err == nil || !strings.Contains(err.Error(), "line 2") {
+		t.Errorf("got %v, want an error on line 2", err)
+	}
+}
+
+// plainEntry mirrors WordEntry without its generated decoder, so
+// encoding/json decodes it without interning anything.
+type plainEntry struct {
+	Word        string         `json:"word"`
+	Meanings    []plainMeaning `json:"meanings"`
+	Suggestions []string       `json:"suggestions"`
+}
+
+type plainMeaning struct {
+	Homograph        int                   `json:"homograph,omitempty"`
+	Origin           *rae.Origin           `json:"origin,omitempty"`
+	Definitions      []plainDefinition     `json:"senses"`
+	Conjugations     *rae.Conjugations     `json:"conjugations,omitempty"`
+	Locutions        []rae.Locution        `json:"locutions,omitempty"`
+	AdditionalSenses []rae.AdditionalSense `json:"additional_senses,omitempty"`
+}
+
+type plainDefinition rae.Definition
+
+// BenchmarkDecode compares encoding/json without interning, encoding/json
+// validating the input before handing it to the generated decoder, the
+// generated decoder alone and a pooled Decoder decoding into one entry. The
+// last three intern enumerated values and synonyms.
+func BenchmarkDecode(b *testing.B) {
+	var raws [][]byte
+	for _, e := range append(raetest.Entries(), benchmarkEntry()) {
+		raw, err := json.Marshal(e)
+		if err != nil {
+			b.Fatal(err)
+		}
+		raws = append(raws, raw)
+	}
+
+	b.Run("no-intern", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			var e plainEntry
+			if err := json.Unmarshal(raws[i%len(raws)], &e); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+
+	b.Run("encoding-json", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			var e rae.WordEntry
+			if err := json.Unmarshal(raws[i%len(raws)], &e); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+
+	b.Run("easyjson", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			var e rae.WordEntry
+			if err := easyjson.Unmarshal(raws[i%len(raws)], &e); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+
+	b.Run("decoder", func(b *testing.B) {
+		d := rae.AcquireDecoder()
+		defer rae.ReleaseDecoder(d)
+		d.SkipEnrichment = true
+		var e rae.WordEntry
+
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			if err := d.Decode(raws[i%len(raws)], &e); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
+
+// benchmarkEntry is an entry with as many senses as a common word, whose
+// labels and synonyms repeat across senses like they do across entries.
+func benchmarkEntry() rae.WordEntry {
+	feminine := rae.GenderFeminine
+	transitive := rae.VerbCategoryTransitive
+
+	var definitions []rae.Definition
+	for i := 1; i <= 20; i++ {
+		d := rae.Definition{
+			MeaningNumber: i,
+			Category:      rae.CategoryNoun,
+			Gender:        &feminine,
+			Usage:         rae.UsageCommon,
+			Description:   fmt.Sprintf("Descripción número %d de la acepción.", i),
+			Synonyms:      []string{"cosa", "objeto", "elemento"},
+			Antonyms:      []string{},
+			Regions:       []rae.Region{rae.RegionMexico},
+		}
+		if i%2 == 0 {
+			d.Category, d.Gender, d.VerbCategory = rae.CategoryVerb, nil, &transitive
+		}
+		definitions = append(definitions, d)
+	}
+
+	return rae.WordEntry{
+		Word:     "pieza",
+		Meanings: []rae.Meaning{{Definitions: definitions}},
+	}
+}
@@ -0,0 +1,339 @@
+package rae
+
+import (
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// ChangeKind tells whether a value was added, removed or modified.
+type ChangeKind string
+
+const (
+	ChangeAdded    ChangeKind = "added"
+	ChangeRemoved  ChangeKind = "removed"
+	ChangeModified ChangeKind = "modified"
+)
+
+// Change is a difference between two versions of an entry. Meaning is the
+// 1-based position of the meaning (its homograph number when it has one) and
+// Sense the MeaningNumber of the sense, zero for meaning-level changes.
+// Field is the JSON name of the changed field, e.g. "description" or
+// "conjugations.indicative.present.singular_first_person", and is empty
+// when a whole sense or meaning was added or removed.
+type Change struct {
+	Kind    ChangeKind `json:"kind"`
+	Meaning int        `json:"meaning"`
+	Sense   int        `json:"sense,omitempty"`
+	Field   string     `json:"field,omitempty"`
+	Old     string     `json:"old,omitempty"`
+	New     string     `json:"new,omitempty"`
+}
+
+var changeSymbols = map[ChangeKind]string{
+	ChangeAdded:    "+",
+	ChangeRemoved:  "-",
+	ChangeModified: "~",
+}
+
+func (c Change) String() string {
+	var b strings.Builder
+
+	b.WriteString(changeSymbols[c.Kind])
+	fmt.Fprintf(&b, " meaning %d", c.Meaning)
+	if c.Sense > 0 {
+		fmt.Fprintf(&b, ", sense %d", c.Sense)
+	}
+	if c.Field != "" {
+		b.WriteString(", " + c.Field)
+	}
+
+	switch c.Kind {
+	case ChangeAdded:
+		fmt.Fprintf(&b, ": %q", c.New)
+	case ChangeRemoved:
+		fmt.Fprintf(&b, ": %q", c.Old)
+	case ChangeModified:
+		fmt.Fprintf(&b, ": %q → %q", c.Old, c.New)
+	}
+
+	return b.String()
+}
+
+// Changes is the result of Diff.
+type Changes []Change
+
+func (c Changes) Empty() bool {
+	return len(c) == 0
+}
+
+// WriteText renders the changes one per line under the headword, in the
+// form used by changelogs.
+func (c Changes) WriteText(w io.Writer, word string) error {
+	if _, err := fmt.Fprintln(w, word); err != nil {
+		return err
+	}
+	for _, change := range c {
+		if _, err := fmt.Fprintf(w, "  %s\n", change); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Diff compares two versions of an entry. Meanings are aligned by position
+// and senses by MeaningNumber, so renumbered senses show up as removed and
+// added.
+func Diff(old, new WordEntry) Changes {
+	var changes Changes
+
+	n := max(len(old.Meanings), len(new.Meanings))
+	for i := 0; i < n; i++ {
+		number := i + 1
+
+		switch {
+		case i >= len(old.Meanings):
+			if h := new.Meanings[i].Homograph; h > 0 {
+				number = h
+			}
+			changes = append(changes, Change{
+				Kind:    ChangeAdded,
+				Meaning: number,
+				New:     meaningSummary(new.Meanings[i]),
+			})
+		case i >= len(new.Meanings):
+			if h := old.Meanings[i].Homograph; h > 0 {
+				number = h
+			}
+			changes = append(changes, Change{
+				Kind:    ChangeRemoved,
+				Meaning: number,
+				Old:     meaningSummary(old.Meanings[i]),
+			})
+		default:
+			if h := new.Meanings[i].Homograph; h > 0 {
+				number = h
+			}
+			changes = append(changes, diffMeaning(number, old.Meanings[i], new.Meanings[i])...)
+		}
+	}
+
+	return changes
+}
+
+func meaningSummary(m Meaning) string {
+	if len(m.Definitions) > 0 {
+		return m.Definitions[0].Description
+	}
+	return ""
+}
+
+func diffMeaning(number int, old, new Meaning) []Change {
+	var changes []Change
+
+	add := func(c Change) {
+		c.Meaning = number
+		changes = append(changes, c)
+	}
+
+	var oldOrigin, newOrigin string
+	if old.Origin != nil {
+		oldOrigin = old.Origin.Raw
+	}
+	if new.Origin != nil {
+		newOrigin = new.Origin.Raw
+	}
+	diffString(add, 0, "origin", oldOrigin, newOrigin)
+
+	oldSenses := sensesByNumber(old.Definitions)
+	newSenses := sensesByNumber(new.Definitions)
+
+	for _, n := range unionKeys(oldSenses, newSenses) {
+		o, inOld := oldSenses[n]
+		d, inNew := newSenses[n]
+
+		switch {
+		case !inOld:
+			add(Change{Kind: ChangeAdded, Sense: n, New: d.Description})
+		case !inNew:
+			add(Change{Kind: ChangeRemoved, Sense: n, Old: o.Description})
+		default:
+			diffSense(add, n, o, d)
+		}
+	}
+
+	diffFlat(add, "conjugations", flattenFields(old.Conjugations), flattenFields(new.Conjugations))
+	diffFlat(add, "locutions", locutionMap(old), locutionMap(new))
+	diffFlat(add, "additional_senses", additionalSenseMap(old), additionalSenseMap(new))
+
+	return changes
+}
+
+func diffSense(add func(Change), n int, old, new Definition) {
+	diffString(add, n, "description", old.Description, new.Description)
+	diffString(add, n, "category", string(old.Category), string(new.Category))
+	diffString(add, n, "verb_category", derefString(old.VerbCategory), derefString(new.VerbCategory))
+	diffString(add, n, "gender", derefString(old.Gender), derefString(new.Gender))
+	diffString(add, n, "usage", string(old.Usage), string(new.Usage))
+	diffFlat(sensed(add, n), "article", flattenFields(old.Article), flattenFields(new.Article))
+	diffSet(add, n, "synonyms", old.Synonyms, new.Synonyms)
+	diffSet(add, n, "antonyms", old.Antonyms, new.Antonyms)
+	diffSet(add, n, "regions", toStrings(old.Regions), toStrings(new.Regions))
+	diffSet(add, n, "domains", toStrings(old.Domains), toStrings(new.Domains))
+}
+
+func diffString(add func(Change), sense int, field, old, new string) {
+	switch {
+	case old == new:
+	case old == "":
+		add(Change{Kind: ChangeAdded, Sense: sense, Field: field, New: new})
+	case new == "":
+		add(Change{Kind: ChangeRemoved, Sense: sense, Field: field, Old: old})
+	default:
+		add(Change{Kind: ChangeModified, Sense: sense, Field: field, Old: old, New: new})
+	}
+}
+
+// diffSet reports the items added to and removed from a list, ignoring
+// their order.
+func diffSet(add func(Change), sense int, field string, old, new []string) {
+	inOld := map[string]bool{}
+	for _, s := range old {
+		inOld[s] = true
+	}
+	inNew := map[string]bool{}
+	for _, s := range new {
+		inNew[s] = true
+	}
+
+	for _, s := range old {
+		if !inNew[s] {
+			add(Change{Kind: ChangeRemoved, Sense: sense, Field: field, Old: s})
+		}
+	}
+	for _, s := range new {
+		if !inOld[s] {
+			add(Change{Kind: ChangeAdded, Sense: sense, Field: field, New: s})
+		}
+	}
+}
+
+// sensed sets the sense of the changes passed to add, for helpers such as
+// diffFlat that report meaning-level changes.
+func sensed(add func(Change), sense int) func(Change) {
+	return func(c Change) {
+		c.Sense = sense
+		add(c)
+	}
+}
+
+func diffFlat(add func(Change), prefix string, old, new map[string]string) {
+	keys := make([]string, 0, len(old)+len(new))
+	for k := range old {
+		keys = append(keys, k)
+	}
+	for k := range new {
+		if _, ok := old[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		diffString(add, 0, prefix+"."+k, old[k], new[k])
+	}
+}
+
+func sensesByNumber(definitions []Definition) map[int]Definition {
+	senses := make(map[int]Definition, len(definitions))
+	for _, d := range definitions {
+		if _, ok := senses[d.MeaningNumber]; !ok {
+			senses[d.MeaningNumber] = d
+		}
+	}
+	return senses
+}
+
+func unionKeys(a, b map[int]Definition) []int {
+	keys := make([]int, 0, len(a)+len(b))
+	for k := range a {
+		keys = append(keys, k)
+	}
+	for k := range b {
+		if _, ok := a[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	sort.Ints(keys)
+	return keys
+}
+
+func locutionMap(m Meaning) map[string]string {
+	locutions := map[string]string{}
+	for _, l := range m.Locutions {
+		locutions[l.Text] = l.Definition
+	}
+	return locutions
+}
+
+// additionalSenseMap maps the definitions of the additional senses to
+// their number, and their locutions to "<number>.locutions.<text>".
+func additionalSenseMap(m Meaning) map[string]string {
+	senses := map[string]string{}
+	for _, s := range m.AdditionalSenses {
+		number := strconv.Itoa(s.Number)
+		if s.Definition != "" {
+			senses[number] = s.Definition
+		}
+		for _, l := range s.Locutions {
+			senses[number+".locutions."+l.Text] = l.Definition
+		}
+	}
+	return senses
+}
+
+func derefString[T ~string](p *T) string {
+	if p == nil {
+		return ""
+	}
+	return string(*p)
+}
+
+func toStrings[T ~string](values []T) []string {
+	out := make([]string, len(values))
+	for i, v := range values {
+		out[i] = string(v)
+	}
+	return out
+}
+
+// flattenFields maps the JSON paths of the non-empty string fields of a
+// struct to their values, e.g. "indicative.present.plural_first_person".
+func flattenFields(v any) map[string]string {
+	out := map[string]string{}
+	flattenValue(reflect.ValueOf(v), "", out)
+	return out
+}
+
+func flattenValue(v reflect.Value, path string, out map[string]string) {
+	for v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return
+		}
+		v = v.Elem()
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		if s := v.String(); s != "" {
+			out[path] = s
+		}
+	case reflect.Struct:
+		for _, f := range jsonFields(v.Type()) {
+			flattenValue(v.Field(f.index), joinPath(path, f.name), out)
+		}
+	}
+}
@@ -0,0 +1,99 @@
+package rae
+
+import (
+	"bytes"
+	"testing"
+)
+
+func diffFixture() WordEntry {
+	return WordEntry{
+		Word: "hablar",
+		Meanings: []Meaning{{
+			Origin: &Origin{Raw: "Del lat. fabulāri."},
+			Definitions: []Definition{
+				{MeaningNumber: 1, Category: CategoryVerb, Description: "Articular palabras.", Synonyms: []string{"decir"}},
+				{MeaningNumber: 2, Category: CategoryVerb, Description: "Conversar."},
+			},
+			Conjugations: &Conjugations{
+				ConjugationNonPersonal: ConjugationNonPersonal{Infinitive: "hablar", Gerund: "hablando"},
+			},
+		}},
+	}
+}
+
+func TestDiffEqual(t *testing.T) {
+	if changes := Diff(diffFixture(), diffFixture()); !changes.Empty() {
+		t.Fatalf("expected no changes, got %v", changes)
+	}
+}
+
+func TestDiff(t *testing.T) {
+	old, new := diffFixture(), diffFixture()
+
+	m := &new.Meanings[0]
+	m.Definitions[0].Description = "Articular, proferir palabras."
+	m.Definitions[0].Synonyms = []string{"expresar"}
+	m.Definitions = append(m.Definitions[:1], Definition{MeaningNumber: 3, Description: "Pronunciar un discurso."})
+	m.Conjugations = &Conjugations{
+		ConjugationNonPersonal: ConjugationNonPersonal{Infinitive: "hablar", Gerund: "hablando", Participle: "hablado"},
+	}
+	new.Meanings = append(new.Meanings, Meaning{
+		Homograph:   2,
+		Definitions: []Definition{{MeaningNumber: 1, Description: "Habla."}},
+	})
+
+	want := Changes{
+		{Kind: ChangeModified, Meaning: 1, Sense: 1, Field: "description", Old: "Articular palabras.", New: "Articular, proferir palabras."},
+		{Kind: ChangeRemoved, Meaning: 1, Sense: 1, Field: "synonyms", Old: "decir"},
+		{Kind: ChangeAdded, Meaning: 1, Sense: 1, Field: "synonyms", New: "expresar"},
+		{Kind: ChangeRemoved, Meaning: 1, Sense: 2, Old: "Conversar."},
+		{Kind: ChangeAdded, Meaning: 1, Sense: 3, New: "Pronunciar un discurso."},
+		{Kind: ChangeAdded, Meaning: 1, Field: "conjugations.non_personal.participle", New: "hablado"},
+		{Kind: ChangeAdded, Meaning: 2, New: "Habla."},
+	}
+
+	got := Diff(old, new)
+	if len(got) != len(want) {
+		t.Fatalf("expected %d changes, got %d: %v", len(want), len(got), got)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("change %d: expected %+v, got %+v", i, want[i], got[i])
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := got[:1].WriteText(&buf, "hablar"); err != nil {
+		t.Fatal(err)
+	}
+	expected := "hablar\n  ~ meaning 1, sense 1, description: \"Articular palabras.\" → \"Articular, proferir palabras.\"\n"
+	if buf.String() != expected {
+		t.Fatalf("unexpected rendering:\n%s", buf.String())
+	}
+}
+
+func TestDiffArticleAndAdditionalSenses(t *testing.T) {
+	old, new := diffFixture(), diffFixture()
+
+	new.Meanings[0].Definitions[1].Article = &Article{Category: ArticleCategoryDefinite, Gender: GenderMasculine}
+	new.Meanings[0].AdditionalSenses = []AdditionalSense{{
+		Number:    1,
+		Locutions: []Locution{{Text: "hablar claro", Definition: "Decir algo sin rodeos."}},
+	}}
+
+	want := Changes{
+		{Kind: ChangeAdded, Meaning: 1, Sense: 2, Field: "article.category", New: "definite"},
+		{Kind: ChangeAdded, Meaning: 1, Sense: 2, Field: "article.gender", New: "masculine"},
+		{Kind: ChangeAdded, Meaning: 1, Field: "additional_senses.1.locutions.hablar claro", New: "Decir algo sin rodeos."},
+	}
+
+	got := Diff(old, new)
+	if len(got) != len(want) {
+		t.Fatalf("expected %d changes, got %d: %v", len(want), len(got), got)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("change %d: expected %+v, got %+v", i, want[i], got[i])
+		}
+	}
+}
@@ -0,0 +1,35 @@
+package rae
+
+// Enrich completes the fields the API left empty with what can be parsed
+// from the raw DLE text of the entry, such as regions, domains, locutions
+// and references. Entries returned by the client are already enriched; call
+// it on entries decoded elsewhere, such as those of a snapshot. Calling it
+// again does nothing.
+func (e *WordEntry) Enrich() {
+	e.fillHomographs()
+
+	for i := range e.Meanings {
+		m := &e.Meanings[i]
+		if m.Origin != nil {
+			m.Origin.fillFromRaw()
+		}
+		// Senses of locutions, such as "a la buena de Dios. 1. loc. adv.",
+		// are numbered on their own, so they are moved to the locutions
+		// not to clash with the senses of the headword.
+		definitions := m.Definitions[:0]
+		for j := range m.Definitions {
+			d := &m.Definitions[j]
+			labels := ParseRaw(d.Raw)
+			if labels.Head != "" {
+				m.addLocution(Locution{Text: labels.Head, Definition: d.Description})
+				continue
+			}
+			d.fillScopes(labels)
+			if d.References == nil {
+				d.References = ParseReferences(d.Description)
+			}
+			definitions = append(definitions, *d)
+		}
+		m.Definitions = definitions
+	}
+}
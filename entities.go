@@ -1,8 +1,9 @@
 package rae
 
 import (
-	"encoding/json"
+	"sync"
 
+	"github.com/mailru/easyjson"
 	"github.com/sonirico/vago/zero"
 )
 
@@ -58,8 +59,28 @@ const (
 type OriginType string
 
 const (
-	OriginLatin     OriginType = "lat"
-	OriginUncertain OriginType = "uncertain"
+	OriginLatin      OriginType = "lat"
+	OriginGreek      OriginType = "gr"
+	OriginArabic     OriginType = "ar"
+	OriginFrench     OriginType = "fr"
+	OriginItalian    OriginType = "it"
+	OriginPortuguese OriginType = "port"
+	OriginCatalan    OriginType = "cat"
+	OriginProvencal  OriginType = "prov"
+	OriginGermanic   OriginType = "germ"
+	OriginGothic     OriginType = "got"
+	OriginGerman     OriginType = "al"
+	OriginEnglish    OriginType = "ingl"
+	OriginDutch      OriginType = "neerl"
+	OriginHebrew     OriginType = "hebr"
+	OriginCeltic     OriginType = "celt"
+	OriginBasque     OriginType = "vasco"
+	OriginMozarabic  OriginType = "mozar"
+	OriginNahuatl    OriginType = "nah"
+	OriginQuechua    OriginType = "quechua"
+	OriginTaino      OriginType = "taino"
+	OriginSpanish    OriginType = "esp" // derived from another Spanish word
+	OriginUncertain  OriginType = "uncertain"
 )
 
 type VoiceType string
@@ -91,22 +112,25 @@ const (
 //
 //easyjson:json
 type Definition struct {
-	Raw           string        `json:"raw"`
-	MeaningNumber int           `json:"meaning_number"`
-	Category      WordCategory  `json:"category"`
-	VerbCategory  *VerbCategory `json:"verb_category,omitempty"`
-	Gender        *Gender       `json:"gender,omitempty"`
-	Article       *Article      `json:"article,omitempty"`
-	Usage         Usage         `json:"usage"`
-	Description   string        `json:"description"`
-	Synonyms      []string      `json:"synonyms"`
-	Antonyms      []string      `json:"antonyms"`
+	Raw           string           `json:"raw"`
+	MeaningNumber int              `json:"meaning_number"`
+	Category      WordCategory     `json:"category,intern"`
+	VerbCategory  *VerbCategory    `json:"verb_category,omitempty,intern"`
+	Gender        *Gender          `json:"gender,omitempty,intern"`
+	Article       *Article         `json:"article,omitempty"`
+	Usage         Usage            `json:"usage,intern"`
+	Description   string           `json:"description"`
+	Synonyms      []string         `json:"synonyms,intern"`
+	Antonyms      []string         `json:"antonyms,intern"`
+	Regions       []Region         `json:"regions,omitempty,intern"`
+	Domains       []Domain         `json:"domains,omitempty,intern"`
+	References    []CrossReference `json:"references,omitempty"`
 }
 
 type Origin struct {
 	Raw   string     `json:"raw"`
-	Type  OriginType `json:"type"`
-	Voice VoiceType  `json:"voice"`
+	Type  OriginType `json:"type,intern"`
+	Voice VoiceType  `json:"voice,intern"`
 	Text  string     `json:"text"`
 }
 
@@ -197,33 +221,62 @@ type Conjugation struct {
 
 //easyjson:json
 type Article struct {
-	Category ArticleCategory `json:"category"`
-	Gender   Gender          `json:"gender"`
+	Category ArticleCategory `json:"category,intern"`
+	Gender   Gender          `json:"gender,intern"`
 }
 
 //easyjson:json
 type Meaning struct {
-	Origin       *Origin       `json:"origin,omitempty"`
-	Definitions  []Definition  `json:"senses"`
-	Conjugations *Conjugations `json:"conjugations,omitempty"`
+	Homograph        int               `json:"homograph,omitempty"`
+	Origin           *Origin           `json:"origin,omitempty"`
+	Definitions      []Definition      `json:"senses"`
+	Conjugations     *Conjugations     `json:"conjugations,omitempty"`
+	Locutions        []Locution        `json:"locutions,omitempty"`
+	AdditionalSenses []AdditionalSense `json:"additional_senses,omitempty"`
 }
 
-type doc struct {
+// Document is a search hit: a headword and its entry as raw JSON.
+type Document struct {
 	Word string `json:"id"`
 	Raw  string `json:"raw"`
 }
 
 //easyjson:json
 type SearchResult struct {
-	Doc  doc `json:"doc"`
-	Hits int `json:"hits"`
+	Doc  Document `json:"doc"`
+	Hits int      `json:"hits"`
+
+	// memo is shared by the copies of a result returned by the client
+	memo *entryMemo
+}
+
+type entryMemo struct {
+	once  sync.Once
+	entry *WordEntry
+	err   error
 }
 
+// WordEntry decodes the entry of the hit. It is safe for concurrent use.
+// The results returned by the client decode it once, on the first call, and
+// return that same entry from then on, from any copy of the result: treat
+// it as read-only, or Clone it before changing it. Other results, such as
+// those built by hand, decode a new entry on every call. See DecodeAll to
+// decode many results at once.
 func (sr *SearchResult) WordEntry() (*WordEntry, error) {
+	if sr.memo == nil {
+		return decodeEntry(sr.Doc.Raw)
+	}
+	sr.memo.once.Do(func() {
+		sr.memo.entry, sr.memo.err = decodeEntry(sr.Doc.Raw)
+	})
+	return sr.memo.entry, sr.memo.err
+}
+
+func decodeEntry(raw string) (*WordEntry, error) {
 	var entry WordEntry
-	err := json.Unmarshal(zero.S2B(sr.Doc.Raw), &entry)
-	if err != nil {
+	if err := easyjson.Unmarshal(zero.S2B(raw), &entry); err != nil {
 		return nil, err
 	}
+	entry.Enrich()
 	return &entry, nil
 }
@@ -46,7 +46,7 @@ func easyjson3e8ab7adDecodeGithubComRaeApiComGoRae(in *jlexer.Lexer, out *WordEn
 				in.Delim('[')
 				if out.Meanings == nil {
 					if !in.IsDelim(']') {
-						out.Meanings = make([]Meaning, 0, 1)
+						out.Meanings = make([]Meaning, 0, 0)
 					} else {
 						out.Meanings = []Meaning{}
 					}
@@ -234,7 +234,7 @@ func (v *SearchResult) UnmarshalJSON(data []byte) error {
 func (v *SearchResult) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson3e8ab7adDecodeGithubComRaeApiComGoRae1(l, v)
 }
-func easyjson3e8ab7adDecodeGithubComRaeApiComGoRae2(in *jlexer.Lexer, out *doc) {
+func easyjson3e8ab7adDecodeGithubComRaeApiComGoRae2(in *jlexer.Lexer, out *Document) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -267,7 +267,7 @@ func easyjson3e8ab7adDecodeGithubComRaeApiComGoRae2(in *jlexer.Lexer, out *doc)
 		in.Consumed()
 	}
 }
-func easyjson3e8ab7adEncodeGithubComRaeApiComGoRae2(out *jwriter.Writer, in doc) {
+func easyjson3e8ab7adEncodeGithubComRaeApiComGoRae2(out *jwriter.Writer, in Document) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -302,6 +302,8 @@ func easyjson3e8ab7adDecodeGithubComRaeApiComGoRae3(in *jlexer.Lexer, out *Meani
 			continue
 		}
 		switch key {
+		case "homograph":
+			out.Homograph = int(in.Int())
 		case "origin":
 			if in.IsNull() {
 				in.Skip()
@@ -345,6 +347,52 @@ func easyjson3e8ab7adDecodeGithubComRaeApiComGoRae3(in *jlexer.Lexer, out *Meani
 				}
 				(*out.Conjugations).UnmarshalEasyJSON(in)
 			}
+		case "locutions":
+			if in.IsNull() {
+				in.Skip()
+				out.Locutions = nil
+			} else {
+				in.Delim('[')
+				if out.Locutions == nil {
+					if !in.IsDelim(']') {
+						out.Locutions = make([]Locution, 0, 2)
+					} else {
+						out.Locutions = []Locution{}
+					}
+				} else {
+					out.Locutions = (out.Locutions)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v8 Locution
+					(v8).UnmarshalEasyJSON(in)
+					out.Locutions = append(out.Locutions, v8)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "additional_senses":
+			if in.IsNull() {
+				in.Skip()
+				out.AdditionalSenses = nil
+			} else {
+				in.Delim('[')
+				if out.AdditionalSenses == nil {
+					if !in.IsDelim(']') {
+						out.AdditionalSenses = make([]AdditionalSense, 0, 1)
+					} else {
+						out.AdditionalSenses = []AdditionalSense{}
+					}
+				} else {
+					out.AdditionalSenses = (out.AdditionalSenses)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v9 AdditionalSense
+					(v9).UnmarshalEasyJSON(in)
+					out.AdditionalSenses = append(out.AdditionalSenses, v9)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -359,10 +407,20 @@ func easyjson3e8ab7adEncodeGithubComRaeApiComGoRae3(out *jwriter.Writer, in Mean
 	out.RawByte('{')
 	first := true
 	_ = first
-	if in.Origin != nil {
-		const prefix string = ",\"origin\":"
+	if in.Homograph != 0 {
+		const prefix string = ",\"homograph\":"
 		first = false
 		out.RawString(prefix[1:])
+		out.Int(int(in.Homograph))
+	}
+	if in.Origin != nil {
+		const prefix string = ",\"origin\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
 		easyjson3e8ab7adEncodeGithubComRaeApiComGoRae4(out, *in.Origin)
 	}
 	{
@@ -377,11 +435,11 @@ func easyjson3e8ab7adEncodeGithubComRaeApiComGoRae3(out *jwriter.Writer, in Mean
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v8, v9 := range in.Definitions {
-				if v8 > 0 {
+			for v10, v11 := range in.Definitions {
+				if v10 > 0 {
 					out.RawByte(',')
 				}
-				(v9).MarshalEasyJSON(out)
+				(v11).MarshalEasyJSON(out)
 			}
 			out.RawByte(']')
 		}
@@ -391,6 +449,34 @@ func easyjson3e8ab7adEncodeGithubComRaeApiComGoRae3(out *jwriter.Writer, in Mean
 		out.RawString(prefix)
 		(*in.Conjugations).MarshalEasyJSON(out)
 	}
+	if len(in.Locutions) != 0 {
+		const prefix string = ",\"locutions\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v12, v13 := range in.Locutions {
+				if v12 > 0 {
+					out.RawByte(',')
+				}
+				(v13).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	if len(in.AdditionalSenses) != 0 {
+		const prefix string = ",\"additional_senses\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v14, v15 := range in.AdditionalSenses {
+				if v14 > 0 {
+					out.RawByte(',')
+				}
+				(v15).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
 	out.RawByte('}')
 }
 
@@ -439,9 +525,9 @@ func easyjson3e8ab7adDecodeGithubComRaeApiComGoRae4(in *jlexer.Lexer, out *Origi
 		case "raw":
 			out.Raw = string(in.String())
 		case "type":
-			out.Type = OriginType(in.String())
+			out.Type = OriginType(in.StringIntern())
 		case "voice":
-			out.Voice = VoiceType(in.String())
+			out.Voice = VoiceType(in.StringIntern())
 		case "text":
 			out.Text = string(in.String())
 		default:
@@ -577,7 +663,7 @@ func easyjson3e8ab7adDecodeGithubComRaeApiComGoRae6(in *jlexer.Lexer, out *Defin
 		case "meaning_number":
 			out.MeaningNumber = int(in.Int())
 		case "category":
-			out.Category = WordCategory(in.String())
+			out.Category = WordCategory(in.StringIntern())
 		case "verb_category":
 			if in.IsNull() {
 				in.Skip()
@@ -586,7 +672,7 @@ func easyjson3e8ab7adDecodeGithubComRaeApiComGoRae6(in *jlexer.Lexer, out *Defin
 				if out.VerbCategory == nil {
 					out.VerbCategory = new(VerbCategory)
 				}
-				*out.VerbCategory = VerbCategory(in.String())
+				*out.VerbCategory = VerbCategory(in.StringIntern())
 			}
 		case "gender":
 			if in.IsNull() {
@@ -596,7 +682,7 @@ func easyjson3e8ab7adDecodeGithubComRaeApiComGoRae6(in *jlexer.Lexer, out *Defin
 				if out.Gender == nil {
 					out.Gender = new(Gender)
 				}
-				*out.Gender = Gender(in.String())
+				*out.Gender = Gender(in.StringIntern())
 			}
 		case "article":
 			if in.IsNull() {
@@ -609,7 +695,7 @@ func easyjson3e8ab7adDecodeGithubComRaeApiComGoRae6(in *jlexer.Lexer, out *Defin
 				(*out.Article).UnmarshalEasyJSON(in)
 			}
 		case "usage":
-			out.Usage = Usage(in.String())
+			out.Usage = Usage(in.StringIntern())
 		case "description":
 			out.Description = string(in.String())
 		case "synonyms":
@@ -628,9 +714,9 @@ func easyjson3e8ab7adDecodeGithubComRaeApiComGoRae6(in *jlexer.Lexer, out *Defin
 					out.Synonyms = (out.Synonyms)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v10 string
-					v10 = string(in.String())
-					out.Synonyms = append(out.Synonyms, v10)
+					var v16 string
+					v16 = string(in.StringIntern())
+					out.Synonyms = append(out.Synonyms, v16)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -651,9 +737,78 @@ func easyjson3e8ab7adDecodeGithubComRaeApiComGoRae6(in *jlexer.Lexer, out *Defin
 					out.Antonyms = (out.Antonyms)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v11 string
-					v11 = string(in.String())
-					out.Antonyms = append(out.Antonyms, v11)
+					var v17 string
+					v17 = string(in.StringIntern())
+					out.Antonyms = append(out.Antonyms, v17)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "regions":
+			if in.IsNull() {
+				in.Skip()
+				out.Regions = nil
+			} else {
+				in.Delim('[')
+				if out.Regions == nil {
+					if !in.IsDelim(']') {
+						out.Regions = make([]Region, 0, 4)
+					} else {
+						out.Regions = []Region{}
+					}
+				} else {
+					out.Regions = (out.Regions)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v18 Region
+					v18 = Region(in.StringIntern())
+					out.Regions = append(out.Regions, v18)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "domains":
+			if in.IsNull() {
+				in.Skip()
+				out.Domains = nil
+			} else {
+				in.Delim('[')
+				if out.Domains == nil {
+					if !in.IsDelim(']') {
+						out.Domains = make([]Domain, 0, 4)
+					} else {
+						out.Domains = []Domain{}
+					}
+				} else {
+					out.Domains = (out.Domains)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v19 Domain
+					v19 = Domain(in.StringIntern())
+					out.Domains = append(out.Domains, v19)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "references":
+			if in.IsNull() {
+				in.Skip()
+				out.References = nil
+			} else {
+				in.Delim('[')
+				if out.References == nil {
+					if !in.IsDelim(']') {
+						out.References = make([]CrossReference, 0, 1)
+					} else {
+						out.References = []CrossReference{}
+					}
+				} else {
+					out.References = (out.References)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v20 CrossReference
+					easyjson3e8ab7adDecodeGithubComRaeApiComGoRae7(in, &v20)
+					out.References = append(out.References, v20)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -719,11 +874,11 @@ func easyjson3e8ab7adEncodeGithubComRaeApiComGoRae6(out *jwriter.Writer, in Defi
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v12, v13 := range in.Synonyms {
-				if v12 > 0 {
+			for v21, v22 := range in.Synonyms {
+				if v21 > 0 {
 					out.RawByte(',')
 				}
-				out.String(string(v13))
+				out.String(string(v22))
 			}
 			out.RawByte(']')
 		}
@@ -735,11 +890,53 @@ func easyjson3e8ab7adEncodeGithubComRaeApiComGoRae6(out *jwriter.Writer, in Defi
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v14, v15 := range in.Antonyms {
-				if v14 > 0 {
+			for v23, v24 := range in.Antonyms {
+				if v23 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v24))
+			}
+			out.RawByte(']')
+		}
+	}
+	if len(in.Regions) != 0 {
+		const prefix string = ",\"regions\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v25, v26 := range in.Regions {
+				if v25 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v26))
+			}
+			out.RawByte(']')
+		}
+	}
+	if len(in.Domains) != 0 {
+		const prefix string = ",\"domains\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v27, v28 := range in.Domains {
+				if v27 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v28))
+			}
+			out.RawByte(']')
+		}
+	}
+	if len(in.References) != 0 {
+		const prefix string = ",\"references\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v29, v30 := range in.References {
+				if v29 > 0 {
 					out.RawByte(',')
 				}
-				out.String(string(v15))
+				easyjson3e8ab7adEncodeGithubComRaeApiComGoRae7(out, v30)
 			}
 			out.RawByte(']')
 		}
@@ -770,7 +967,71 @@ func (v *Definition) UnmarshalJSON(data []byte) error {
 func (v *Definition) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson3e8ab7adDecodeGithubComRaeApiComGoRae6(l, v)
 }
-func easyjson3e8ab7adDecodeGithubComRaeApiComGoRae7(in *jlexer.Lexer, out *Conjugations) {
+func easyjson3e8ab7adDecodeGithubComRaeApiComGoRae7(in *jlexer.Lexer, out *CrossReference) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "kind":
+			out.Kind = ReferenceKind(in.String())
+		case "target":
+			out.Target = string(in.String())
+		case "entry":
+			if in.IsNull() {
+				in.Skip()
+				out.Entry = nil
+			} else {
+				if out.Entry == nil {
+					out.Entry = new(WordEntry)
+				}
+				(*out.Entry).UnmarshalEasyJSON(in)
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson3e8ab7adEncodeGithubComRaeApiComGoRae7(out *jwriter.Writer, in CrossReference) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"kind\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Kind))
+	}
+	{
+		const prefix string = ",\"target\":"
+		out.RawString(prefix)
+		out.String(string(in.Target))
+	}
+	if in.Entry != nil {
+		const prefix string = ",\"entry\":"
+		out.RawString(prefix)
+		(*in.Entry).MarshalEasyJSON(out)
+	}
+	out.RawByte('}')
+}
+func easyjson3e8ab7adDecodeGithubComRaeApiComGoRae8(in *jlexer.Lexer, out *Conjugations) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -807,7 +1068,7 @@ func easyjson3e8ab7adDecodeGithubComRaeApiComGoRae7(in *jlexer.Lexer, out *Conju
 		in.Consumed()
 	}
 }
-func easyjson3e8ab7adEncodeGithubComRaeApiComGoRae7(out *jwriter.Writer, in Conjugations) {
+func easyjson3e8ab7adEncodeGithubComRaeApiComGoRae8(out *jwriter.Writer, in Conjugations) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -837,27 +1098,27 @@ func easyjson3e8ab7adEncodeGithubComRaeApiComGoRae7(out *jwriter.Writer, in Conj
 // MarshalJSON supports json.Marshaler interface
 func (v Conjugations) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson3e8ab7adEncodeGithubComRaeApiComGoRae7(&w, v)
+	easyjson3e8ab7adEncodeGithubComRaeApiComGoRae8(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v Conjugations) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson3e8ab7adEncodeGithubComRaeApiComGoRae7(w, v)
+	easyjson3e8ab7adEncodeGithubComRaeApiComGoRae8(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *Conjugations) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson3e8ab7adDecodeGithubComRaeApiComGoRae7(&r, v)
+	easyjson3e8ab7adDecodeGithubComRaeApiComGoRae8(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *Conjugations) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson3e8ab7adDecodeGithubComRaeApiComGoRae7(l, v)
+	easyjson3e8ab7adDecodeGithubComRaeApiComGoRae8(l, v)
 }
-func easyjson3e8ab7adDecodeGithubComRaeApiComGoRae8(in *jlexer.Lexer, out *ConjugationSubjunctive) {
+func easyjson3e8ab7adDecodeGithubComRaeApiComGoRae9(in *jlexer.Lexer, out *ConjugationSubjunctive) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -898,7 +1159,7 @@ func easyjson3e8ab7adDecodeGithubComRaeApiComGoRae8(in *jlexer.Lexer, out *Conju
 		in.Consumed()
 	}
 }
-func easyjson3e8ab7adEncodeGithubComRaeApiComGoRae8(out *jwriter.Writer, in ConjugationSubjunctive) {
+func easyjson3e8ab7adEncodeGithubComRaeApiComGoRae9(out *jwriter.Writer, in ConjugationSubjunctive) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -938,27 +1199,27 @@ func easyjson3e8ab7adEncodeGithubComRaeApiComGoRae8(out *jwriter.Writer, in Conj
 // MarshalJSON supports json.Marshaler interface
 func (v ConjugationSubjunctive) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson3e8ab7adEncodeGithubComRaeApiComGoRae8(&w, v)
+	easyjson3e8ab7adEncodeGithubComRaeApiComGoRae9(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v ConjugationSubjunctive) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson3e8ab7adEncodeGithubComRaeApiComGoRae8(w, v)
+	easyjson3e8ab7adEncodeGithubComRaeApiComGoRae9(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *ConjugationSubjunctive) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson3e8ab7adDecodeGithubComRaeApiComGoRae8(&r, v)
+	easyjson3e8ab7adDecodeGithubComRaeApiComGoRae9(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *ConjugationSubjunctive) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson3e8ab7adDecodeGithubComRaeApiComGoRae8(l, v)
+	easyjson3e8ab7adDecodeGithubComRaeApiComGoRae9(l, v)
 }
-func easyjson3e8ab7adDecodeGithubComRaeApiComGoRae9(in *jlexer.Lexer, out *ConjugationNonPersonal) {
+func easyjson3e8ab7adDecodeGithubComRaeApiComGoRae10(in *jlexer.Lexer, out *ConjugationNonPersonal) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -997,7 +1258,7 @@ func easyjson3e8ab7adDecodeGithubComRaeApiComGoRae9(in *jlexer.Lexer, out *Conju
 		in.Consumed()
 	}
 }
-func easyjson3e8ab7adEncodeGithubComRaeApiComGoRae9(out *jwriter.Writer, in ConjugationNonPersonal) {
+func easyjson3e8ab7adEncodeGithubComRaeApiComGoRae10(out *jwriter.Writer, in ConjugationNonPersonal) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -1032,27 +1293,27 @@ func easyjson3e8ab7adEncodeGithubComRaeApiComGoRae9(out *jwriter.Writer, in Conj
 // MarshalJSON supports json.Marshaler interface
 func (v ConjugationNonPersonal) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson3e8ab7adEncodeGithubComRaeApiComGoRae9(&w, v)
+	easyjson3e8ab7adEncodeGithubComRaeApiComGoRae10(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v ConjugationNonPersonal) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson3e8ab7adEncodeGithubComRaeApiComGoRae9(w, v)
+	easyjson3e8ab7adEncodeGithubComRaeApiComGoRae10(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *ConjugationNonPersonal) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson3e8ab7adDecodeGithubComRaeApiComGoRae9(&r, v)
+	easyjson3e8ab7adDecodeGithubComRaeApiComGoRae10(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *ConjugationNonPersonal) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson3e8ab7adDecodeGithubComRaeApiComGoRae9(l, v)
+	easyjson3e8ab7adDecodeGithubComRaeApiComGoRae10(l, v)
 }
-func easyjson3e8ab7adDecodeGithubComRaeApiComGoRae10(in *jlexer.Lexer, out *ConjugationIndicative) {
+func easyjson3e8ab7adDecodeGithubComRaeApiComGoRae11(in *jlexer.Lexer, out *ConjugationIndicative) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1101,7 +1362,7 @@ func easyjson3e8ab7adDecodeGithubComRaeApiComGoRae10(in *jlexer.Lexer, out *Conj
 		in.Consumed()
 	}
 }
-func easyjson3e8ab7adEncodeGithubComRaeApiComGoRae10(out *jwriter.Writer, in ConjugationIndicative) {
+func easyjson3e8ab7adEncodeGithubComRaeApiComGoRae11(out *jwriter.Writer, in ConjugationIndicative) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -1161,27 +1422,27 @@ func easyjson3e8ab7adEncodeGithubComRaeApiComGoRae10(out *jwriter.Writer, in Con
 // MarshalJSON supports json.Marshaler interface
 func (v ConjugationIndicative) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson3e8ab7adEncodeGithubComRaeApiComGoRae10(&w, v)
+	easyjson3e8ab7adEncodeGithubComRaeApiComGoRae11(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v ConjugationIndicative) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson3e8ab7adEncodeGithubComRaeApiComGoRae10(w, v)
+	easyjson3e8ab7adEncodeGithubComRaeApiComGoRae11(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *ConjugationIndicative) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson3e8ab7adDecodeGithubComRaeApiComGoRae10(&r, v)
+	easyjson3e8ab7adDecodeGithubComRaeApiComGoRae11(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *ConjugationIndicative) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson3e8ab7adDecodeGithubComRaeApiComGoRae10(l, v)
+	easyjson3e8ab7adDecodeGithubComRaeApiComGoRae11(l, v)
 }
-func easyjson3e8ab7adDecodeGithubComRaeApiComGoRae11(in *jlexer.Lexer, out *ConjugationImperative) {
+func easyjson3e8ab7adDecodeGithubComRaeApiComGoRae12(in *jlexer.Lexer, out *ConjugationImperative) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1218,7 +1479,7 @@ func easyjson3e8ab7adDecodeGithubComRaeApiComGoRae11(in *jlexer.Lexer, out *Conj
 		in.Consumed()
 	}
 }
-func easyjson3e8ab7adEncodeGithubComRaeApiComGoRae11(out *jwriter.Writer, in ConjugationImperative) {
+func easyjson3e8ab7adEncodeGithubComRaeApiComGoRae12(out *jwriter.Writer, in ConjugationImperative) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -1248,27 +1509,27 @@ func easyjson3e8ab7adEncodeGithubComRaeApiComGoRae11(out *jwriter.Writer, in Con
 // MarshalJSON supports json.Marshaler interface
 func (v ConjugationImperative) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson3e8ab7adEncodeGithubComRaeApiComGoRae11(&w, v)
+	easyjson3e8ab7adEncodeGithubComRaeApiComGoRae12(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v ConjugationImperative) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson3e8ab7adEncodeGithubComRaeApiComGoRae11(w, v)
+	easyjson3e8ab7adEncodeGithubComRaeApiComGoRae12(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *ConjugationImperative) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson3e8ab7adDecodeGithubComRaeApiComGoRae11(&r, v)
+	easyjson3e8ab7adDecodeGithubComRaeApiComGoRae12(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *ConjugationImperative) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson3e8ab7adDecodeGithubComRaeApiComGoRae11(l, v)
+	easyjson3e8ab7adDecodeGithubComRaeApiComGoRae12(l, v)
 }
-func easyjson3e8ab7adDecodeGithubComRaeApiComGoRae12(in *jlexer.Lexer, out *Conjugation) {
+func easyjson3e8ab7adDecodeGithubComRaeApiComGoRae13(in *jlexer.Lexer, out *Conjugation) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1313,7 +1574,7 @@ func easyjson3e8ab7adDecodeGithubComRaeApiComGoRae12(in *jlexer.Lexer, out *Conj
 		in.Consumed()
 	}
 }
-func easyjson3e8ab7adEncodeGithubComRaeApiComGoRae12(out *jwriter.Writer, in Conjugation) {
+func easyjson3e8ab7adEncodeGithubComRaeApiComGoRae13(out *jwriter.Writer, in Conjugation) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -1363,27 +1624,27 @@ func easyjson3e8ab7adEncodeGithubComRaeApiComGoRae12(out *jwriter.Writer, in Con
 // MarshalJSON supports json.Marshaler interface
 func (v Conjugation) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson3e8ab7adEncodeGithubComRaeApiComGoRae12(&w, v)
+	easyjson3e8ab7adEncodeGithubComRaeApiComGoRae13(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v Conjugation) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson3e8ab7adEncodeGithubComRaeApiComGoRae12(w, v)
+	easyjson3e8ab7adEncodeGithubComRaeApiComGoRae13(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *Conjugation) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson3e8ab7adDecodeGithubComRaeApiComGoRae12(&r, v)
+	easyjson3e8ab7adDecodeGithubComRaeApiComGoRae13(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *Conjugation) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson3e8ab7adDecodeGithubComRaeApiComGoRae12(l, v)
+	easyjson3e8ab7adDecodeGithubComRaeApiComGoRae13(l, v)
 }
-func easyjson3e8ab7adDecodeGithubComRaeApiComGoRae13(in *jlexer.Lexer, out *Article) {
+func easyjson3e8ab7adDecodeGithubComRaeApiComGoRae14(in *jlexer.Lexer, out *Article) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1403,9 +1664,9 @@ func easyjson3e8ab7adDecodeGithubComRaeApiComGoRae13(in *jlexer.Lexer, out *Arti
 		}
 		switch key {
 		case "category":
-			out.Category = ArticleCategory(in.String())
+			out.Category = ArticleCategory(in.StringIntern())
 		case "gender":
-			out.Gender = Gender(in.String())
+			out.Gender = Gender(in.StringIntern())
 		default:
 			in.SkipRecursive()
 		}
@@ -1416,7 +1677,7 @@ func easyjson3e8ab7adDecodeGithubComRaeApiComGoRae13(in *jlexer.Lexer, out *Arti
 		in.Consumed()
 	}
 }
-func easyjson3e8ab7adEncodeGithubComRaeApiComGoRae13(out *jwriter.Writer, in Article) {
+func easyjson3e8ab7adEncodeGithubComRaeApiComGoRae14(out *jwriter.Writer, in Article) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -1436,27 +1697,27 @@ func easyjson3e8ab7adEncodeGithubComRaeApiComGoRae13(out *jwriter.Writer, in Art
 // MarshalJSON supports json.Marshaler interface
 func (v Article) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson3e8ab7adEncodeGithubComRaeApiComGoRae13(&w, v)
+	easyjson3e8ab7adEncodeGithubComRaeApiComGoRae14(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v Article) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson3e8ab7adEncodeGithubComRaeApiComGoRae13(w, v)
+	easyjson3e8ab7adEncodeGithubComRaeApiComGoRae14(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *Article) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson3e8ab7adDecodeGithubComRaeApiComGoRae13(&r, v)
+	easyjson3e8ab7adDecodeGithubComRaeApiComGoRae14(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *Article) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson3e8ab7adDecodeGithubComRaeApiComGoRae13(l, v)
+	easyjson3e8ab7adDecodeGithubComRaeApiComGoRae14(l, v)
 }
-func easyjson3e8ab7adDecodeGithubComRaeApiComGoRae14(in *jlexer.Lexer, out *AdditionalSense) {
+func easyjson3e8ab7adDecodeGithubComRaeApiComGoRae15(in *jlexer.Lexer, out *AdditionalSense) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1495,9 +1756,9 @@ func easyjson3e8ab7adDecodeGithubComRaeApiComGoRae14(in *jlexer.Lexer, out *Addi
 					out.Locutions = (out.Locutions)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v16 Locution
-					(v16).UnmarshalEasyJSON(in)
-					out.Locutions = append(out.Locutions, v16)
+					var v31 Locution
+					(v31).UnmarshalEasyJSON(in)
+					out.Locutions = append(out.Locutions, v31)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -1512,7 +1773,7 @@ func easyjson3e8ab7adDecodeGithubComRaeApiComGoRae14(in *jlexer.Lexer, out *Addi
 		in.Consumed()
 	}
 }
-func easyjson3e8ab7adEncodeGithubComRaeApiComGoRae14(out *jwriter.Writer, in AdditionalSense) {
+func easyjson3e8ab7adEncodeGithubComRaeApiComGoRae15(out *jwriter.Writer, in AdditionalSense) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -1533,11 +1794,11 @@ func easyjson3e8ab7adEncodeGithubComRaeApiComGoRae14(out *jwriter.Writer, in Add
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v17, v18 := range in.Locutions {
-				if v17 > 0 {
+			for v32, v33 := range in.Locutions {
+				if v32 > 0 {
 					out.RawByte(',')
 				}
-				(v18).MarshalEasyJSON(out)
+				(v33).MarshalEasyJSON(out)
 			}
 			out.RawByte(']')
 		}
@@ -1548,23 +1809,23 @@ func easyjson3e8ab7adEncodeGithubComRaeApiComGoRae14(out *jwriter.Writer, in Add
 // MarshalJSON supports json.Marshaler interface
 func (v AdditionalSense) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson3e8ab7adEncodeGithubComRaeApiComGoRae14(&w, v)
+	easyjson3e8ab7adEncodeGithubComRaeApiComGoRae15(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v AdditionalSense) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson3e8ab7adEncodeGithubComRaeApiComGoRae14(w, v)
+	easyjson3e8ab7adEncodeGithubComRaeApiComGoRae15(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *AdditionalSense) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson3e8ab7adDecodeGithubComRaeApiComGoRae14(&r, v)
+	easyjson3e8ab7adDecodeGithubComRaeApiComGoRae15(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *AdditionalSense) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson3e8ab7adDecodeGithubComRaeApiComGoRae14(l, v)
+	easyjson3e8ab7adDecodeGithubComRaeApiComGoRae15(l, v)
 }
@@ -0,0 +1,254 @@
+package rae
+
+import (
+	"fmt"
+	"slices"
+)
+
+// Locale selects the language of the display names of the enums.
+type Locale string
+
+const (
+	LocaleSpanish Locale = "es"
+	LocaleEnglish Locale = "en"
+)
+
+// enumName holds the display names and DLE abbreviation of an enum value.
+type enumName struct {
+	value string
+	es    string
+	en    string
+	abbr  string
+}
+
+type enumTable[T ~string] struct {
+	values []T
+	names  map[T]enumName
+}
+
+func newEnumTable[T ~string](names ...enumName) enumTable[T] {
+	t := enumTable[T]{names: make(map[T]enumName, len(names))}
+	for _, n := range names {
+		t.values = append(t.values, T(n.value))
+		t.names[T(n.value)] = n
+	}
+	return t
+}
+
+func (t enumTable[T]) valid(v T) bool {
+	_, ok := t.names[v]
+	return ok
+}
+
+func (t enumTable[T]) name(v T, locale Locale) string {
+	n, ok := t.names[v]
+	if !ok {
+		return string(v)
+	}
+	if locale == LocaleEnglish {
+		return n.en
+	}
+	return n.es
+}
+
+var wordCategories = newEnumTable[WordCategory](
+	enumName{string(CategoryArticle), "artículo", "article", "art."},
+	enumName{string(CategoryNoun), "sustantivo", "noun", "s."},
+	enumName{string(CategoryPronoun), "pronombre", "pronoun", "pron."},
+	enumName{string(CategoryAdjective), "adjetivo", "adjective", "adj."},
+	enumName{string(CategoryVerb), "verbo", "verb", "v."},
+	enumName{string(CategoryAdverb), "adverbio", "adverb", "adv."},
+	enumName{string(CategoryPreposition), "preposición", "preposition", "prep."},
+	enumName{string(CategoryConjunction), "conjunción", "conjunction", "conj."},
+	enumName{string(CategoryInterjection), "interjección", "interjection", "interj."},
+)
+
+var verbCategories = newEnumTable[VerbCategory](
+	enumName{string(VerbCategoryTransitive), "transitivo", "transitive", "tr."},
+	enumName{string(VerbCategoryIntransitive), "intransitivo", "intransitive", "intr."},
+	enumName{string(VerbCategoryCopulative), "copulativo", "copulative", "cop."},
+	enumName{string(VerbCategoryReflexive), "reflexivo", "reflexive", ""},
+	enumName{string(VerbCategoryDefective), "defectivo", "defective", "defect."},
+	enumName{string(VerbCategoryPronominal), "pronominal", "pronominal", "prnl."},
+	enumName{string(VerbCategoryAuxiliary), "auxiliar", "auxiliary", "aux."},
+	enumName{string(VerbCategoryPredicative), "predicativo", "predicative", ""},
+)
+
+var articleCategories = newEnumTable[ArticleCategory](
+	enumName{string(ArticleCategoryDefinite), "determinado", "definite", ""},
+	enumName{string(ArticleCategoryIndefinite), "indeterminado", "indefinite", ""},
+	enumName{string(ArticleCategoryNeuter), "neutro", "neuter", ""},
+)
+
+var usages = newEnumTable[Usage](
+	enumName{string(UsageCommon), "común", "common", ""},
+	enumName{string(UsageRare), "poco usado", "rare", "p. us."},
+	enumName{string(UsageOutdated), "anticuado", "outdated", "ant."},
+	enumName{string(UsageColloquial), "coloquial", "colloquial", "coloq."},
+	enumName{string(UsageObsolete), "desusado", "obsolete", "desus."},
+	enumName{string(UsageUnknown), "desconocido", "unknown", ""},
+)
+
+var originTypes = newEnumTable[OriginType](
+	enumName{string(OriginLatin), "latín", "Latin", "lat."},
+	enumName{string(OriginGreek), "griego", "Greek", "gr."},
+	enumName{string(OriginArabic), "árabe", "Arabic", "ár."},
+	enumName{string(OriginFrench), "francés", "French", "fr."},
+	enumName{string(OriginItalian), "italiano", "Italian", "it."},
+	enumName{string(OriginPortuguese), "portugués", "Portuguese", "port."},
+	enumName{string(OriginCatalan), "catalán", "Catalan", "cat."},
+	enumName{string(OriginProvencal), "provenzal", "Provençal", "prov."},
+	enumName{string(OriginGermanic), "germánico", "Germanic", "germ."},
+	enumName{string(OriginGothic), "gótico", "Gothic", "gót."},
+	enumName{string(OriginGerman), "alemán", "German", "al."},
+	enumName{string(OriginEnglish), "inglés", "English", "ingl."},
+	enumName{string(OriginDutch), "neerlandés", "Dutch", "neerl."},
+	enumName{string(OriginHebrew), "hebreo", "Hebrew", "hebr."},
+	enumName{string(OriginCeltic), "celta", "Celtic", "celt."},
+	enumName{string(OriginBasque), "vasco", "Basque", "vasco"},
+	enumName{string(OriginMozarabic), "mozárabe", "Mozarabic", "mozár."},
+	enumName{string(OriginNahuatl), "náhuatl", "Nahuatl", "náh."},
+	enumName{string(OriginQuechua), "quechua", "Quechua", "quechua"},
+	enumName{string(OriginTaino), "taíno", "Taino", "taíno"},
+	enumName{string(OriginSpanish), "español", "Spanish", ""},
+	enumName{string(OriginUncertain), "origen incierto", "uncertain origin", "or. inc."},
+)
+
+var voiceTypes = newEnumTable[VoiceType](
+	enumName{string(VoiceOnomatopoeic), "onomatopéyica", "onomatopoeic", "onomat."},
+	enumName{string(VoiceExpressive), "expresiva", "expressive", "expr."},
+)
+
+var genders = newEnumTable[Gender](
+	enumName{string(GenderMasculine), "masculino", "masculine", "m."},
+	enumName{string(GenderFeminine), "femenino", "feminine", "f."},
+	enumName{string(GenderBoth), "masculino y femenino", "masculine and feminine", "m. y f."},
+	enumName{string(GenderUnknown), "desconocido", "unknown", ""},
+)
+
+var verbalModes = newEnumTable[VerbalMode](
+	enumName{string(VerbalModeIndicative), "indicativo", "indicative", "indic."},
+	enumName{string(VerbalModeSubjunctive), "subjuntivo", "subjunctive", "subj."},
+	enumName{string(VerbalModeImperative), "imperativo", "imperative", "imperat."},
+	enumName{string(VerbalModeNonPersonal), "formas no personales", "non-personal forms", ""},
+)
+
+func (c WordCategory) IsValid() bool             { return wordCategories.valid(c) }
+func (WordCategory) Values() []WordCategory      { return slices.Clone(wordCategories.values) }
+func (c WordCategory) String() string            { return string(c) }
+func (c WordCategory) Spanish() string           { return wordCategories.name(c, LocaleSpanish) }
+func (c WordCategory) Name(locale Locale) string { return wordCategories.name(c, locale) }
+func (c WordCategory) Abbr() string              { return wordCategories.names[c].abbr }
+
+func (c VerbCategory) IsValid() bool             { return verbCategories.valid(c) }
+func (VerbCategory) Values() []VerbCategory      { return slices.Clone(verbCategories.values) }
+func (c VerbCategory) String() string            { return string(c) }
+func (c VerbCategory) Spanish() string           { return verbCategories.name(c, LocaleSpanish) }
+func (c VerbCategory) Name(locale Locale) string { return verbCategories.name(c, locale) }
+func (c VerbCategory) Abbr() string              { return verbCategories.names[c].abbr }
+
+func (c ArticleCategory) IsValid() bool             { return articleCategories.valid(c) }
+func (ArticleCategory) Values() []ArticleCategory   { return slices.Clone(articleCategories.values) }
+func (c ArticleCategory) String() string            { return string(c) }
+func (c ArticleCategory) Spanish() string           { return articleCategories.name(c, LocaleSpanish) }
+func (c ArticleCategory) Name(locale Locale) string { return articleCategories.name(c, locale) }
+func (c ArticleCategory) Abbr() string              { return articleCategories.names[c].abbr }
+
+func (u Usage) IsValid() bool             { return usages.valid(u) }
+func (Usage) Values() []Usage             { return slices.Clone(usages.values) }
+func (u Usage) String() string            { return string(u) }
+func (u Usage) Spanish() string           { return usages.name(u, LocaleSpanish) }
+func (u Usage) Name(locale Locale) string { return usages.name(u, locale) }
+func (u Usage) Abbr() string              { return usages.names[u].abbr }
+
+func (o OriginType) IsValid() bool             { return originTypes.valid(o) }
+func (OriginType) Values() []OriginType        { return slices.Clone(originTypes.values) }
+func (o OriginType) String() string            { return string(o) }
+func (o OriginType) Spanish() string           { return originTypes.name(o, LocaleSpanish) }
+func (o OriginType) Name(locale Locale) string { return originTypes.name(o, locale) }
+func (o OriginType) Abbr() string              { return originTypes.names[o].abbr }
+
+func (v VoiceType) IsValid() bool             { return voiceTypes.valid(v) }
+func (VoiceType) Values() []VoiceType         { return slices.Clone(voiceTypes.values) }
+func (v VoiceType) String() string            { return string(v) }
+func (v VoiceType) Spanish() string           { return voiceTypes.name(v, LocaleSpanish) }
+func (v VoiceType) Name(locale Locale) string { return voiceTypes.name(v, locale) }
+func (v VoiceType) Abbr() string              { return voiceTypes.names[v].abbr }
+
+func (g Gender) IsValid() bool             { return genders.valid(g) }
+func (Gender) Values() []Gender            { return slices.Clone(genders.values) }
+func (g Gender) String() string            { return string(g) }
+func (g Gender) Spanish() string           { return genders.name(g, LocaleSpanish) }
+func (g Gender) Name(locale Locale) string { return genders.name(g, locale) }
+func (g Gender) Abbr() string              { return genders.names[g].abbr }
+
+func (m VerbalMode) IsValid() bool             { return verbalModes.valid(m) }
+func (VerbalMode) Values() []VerbalMode        { return slices.Clone(verbalModes.values) }
+func (m VerbalMode) String() string            { return string(m) }
+func (m VerbalMode) Spanish() string           { return verbalModes.name(m, LocaleSpanish) }
+func (m VerbalMode) Name(locale Locale) string { return verbalModes.name(m, locale) }
+func (m VerbalMode) Abbr() string              { return verbalModes.names[m].abbr }
+
+// UnknownValue is an enum value received from the API that this package does
+// not know about. Path locates it in the entry, e.g.
+// "meanings[0].senses[2].usage".
+type UnknownValue struct {
+	Type  string `json:"type"`
+	Value string `json:"value"`
+	Path  string `json:"path"`
+}
+
+func (u UnknownValue) String() string {
+	return fmt.Sprintf("unknown %s %q at %s", u.Type, u.Value, u.Path)
+}
+
+// UnknownValues walks the entry and returns every non-empty enum value that
+// is not a known constant. Unknown values are kept as decoded, this only
+// reports them.
+func (e WordEntry) UnknownValues() []UnknownValue {
+	var unknown []UnknownValue
+
+	check := func(valid bool, typ, value, path string) {
+		if value != "" && !valid {
+			unknown = append(unknown, UnknownValue{Type: typ, Value: value, Path: path})
+		}
+	}
+
+	for i, m := range e.Meanings {
+		mp := fmt.Sprintf("meanings[%d]", i)
+
+		if o := m.Origin; o != nil {
+			check(o.Type.IsValid(), "OriginType", string(o.Type), mp+".origin.type")
+			check(o.Voice.IsValid(), "VoiceType", string(o.Voice), mp+".origin.voice")
+		}
+
+		for j, d := range m.Definitions {
+			dp := fmt.Sprintf("%s.senses[%d]", mp, j)
+
+			check(d.Category.IsValid(), "WordCategory", string(d.Category), dp+".category")
+			check(d.Usage.IsValid(), "Usage", string(d.Usage), dp+".usage")
+			if d.VerbCategory != nil {
+				check(
+					d.VerbCategory.IsValid(),
+					"VerbCategory",
+					string(*d.VerbCategory),
+					dp+".verb_category",
+				)
+			}
+			if d.Gender != nil {
+				check(d.Gender.IsValid(), "Gender", string(*d.Gender), dp+".gender")
+			}
+			if a := d.Article; a != nil {
+				check(
+					a.Category.IsValid(),
+					"ArticleCategory",
+					string(a.Category),
+					dp+".article.category",
+				)
+				check(a.Gender.IsValid(), "Gender", string(a.Gender), dp+".article.gender")
+			}
+		}
+	}
+
+	return unknown
+}
@@ -0,0 +1,48 @@
+package rae
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestEnums(t *testing.T) {
+	if !UsageObsolete.IsValid() || Usage("vulgar").IsValid() {
+		t.Error("unexpected Usage validation")
+	}
+	if got := UsageObsolete.Spanish(); got != "desusado" {
+		t.Errorf("expected desusado, got %q", got)
+	}
+	if got := CategoryNoun.Name(LocaleEnglish); got != "noun" {
+		t.Errorf("expected noun, got %q", got)
+	}
+	if got := VerbCategoryTransitive.Abbr(); got != "tr." {
+		t.Errorf("expected tr., got %q", got)
+	}
+	if got := Gender("neutral").Spanish(); got != "neutral" {
+		t.Errorf("expected unknown values to name themselves, got %q", got)
+	}
+	if n := len(Gender("").Values()); n != 4 {
+		t.Errorf("expected 4 genders, got %d", n)
+	}
+}
+
+func TestUnknownValues(t *testing.T) {
+	entry := WordEntry{
+		Meanings: []Meaning{{
+			Origin: &Origin{Type: "sánscrito"},
+			Definitions: []Definition{
+				{Category: CategoryNoun, Usage: UsageCommon},
+				{Category: "numeral", Usage: "vulgar"},
+			},
+		}},
+	}
+
+	want := []UnknownValue{
+		{Type: "OriginType", Value: "sánscrito", Path: "meanings[0].origin.type"},
+		{Type: "WordCategory", Value: "numeral", Path: "meanings[0].senses[1].category"},
+		{Type: "Usage", Value: "vulgar", Path: "meanings[0].senses[1].usage"},
+	}
+	if got := entry.UnknownValues(); !reflect.DeepEqual(got, want) {
+		t.Errorf("want %+v, got %+v", want, got)
+	}
+}
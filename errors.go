@@ -3,5 +3,9 @@ package rae
 import "errors"
 
 var (
-	ErrWordNotFound = errors.New("word not found")
+	ErrWordNotFound     = errors.New("word not found")
+	ErrLocutionNotFound = errors.New("locution not found")
+	ErrSchemaDrift      = errors.New("response does not match the schema")
+	ErrInvalidInput     = errors.New("invalid input")
+	ErrAmbiguousWord    = errors.New("ambiguous word")
 )
@@ -0,0 +1,214 @@
+package rae
+
+import (
+	"regexp"
+	"strings"
+)
+
+// EtymologyStep is a single link of an etymology chain, e.g. the
+// "del ár. clás. dawlah 'dinastía'" part of an origin.
+type EtymologyStep struct {
+	Language OriginType `json:"language"`
+	Abbr     string     `json:"abbr,omitempty"`
+	Variety  string     `json:"variety,omitempty"`
+	Etymon   string     `json:"etymon"`
+	Gloss    string     `json:"gloss,omitempty"`
+}
+
+// Etymology is the structured form of Origin.Raw. Chain is ordered from the
+// nearest source to the most remote one. When the origin cannot be parsed
+// Parsed is false and only Raw is set.
+type Etymology struct {
+	Raw       string          `json:"raw"`
+	Chain     []EtymologyStep `json:"chain,omitempty"`
+	Voice     VoiceType       `json:"voice,omitempty"`
+	Uncertain bool            `json:"uncertain,omitempty"`
+	Parsed    bool            `json:"parsed"`
+}
+
+var originLanguages = map[string]OriginType{
+	"lat.":    OriginLatin,
+	"gr.":     OriginGreek,
+	"ár.":     OriginArabic,
+	"fr.":     OriginFrench,
+	"it.":     OriginItalian,
+	"port.":   OriginPortuguese,
+	"cat.":    OriginCatalan,
+	"prov.":   OriginProvencal,
+	"occit.":  OriginProvencal,
+	"germ.":   OriginGermanic,
+	"gót.":    OriginGothic,
+	"al.":     OriginGerman,
+	"ingl.":   OriginEnglish,
+	"neerl.":  OriginDutch,
+	"hebr.":   OriginHebrew,
+	"celt.":   OriginCeltic,
+	"vasco":   OriginBasque,
+	"mozár.":  OriginMozarabic,
+	"náh.":    OriginNahuatl,
+	"náhuatl": OriginNahuatl,
+	"quechua": OriginQuechua,
+	"taíno":   OriginTaino,
+}
+
+// originVarieties qualify a language, as in "lat. vulg." or "ár. hisp.".
+var originVarieties = map[string]bool{
+	"ant.":     true,
+	"biz.":     true,
+	"cient.":   true,
+	"clás.":    true,
+	"dialect.": true,
+	"hisp.":    true,
+	"medio":    true,
+	"mediev.":  true,
+	"mod.":     true,
+	"tardío":   true,
+	"vulg.":    true,
+}
+
+var originVoices = map[string]VoiceType{
+	"onomat.":       VoiceOnomatopoeic,
+	"onomatopeya":   VoiceOnomatopoeic,
+	"onomatopéyica": VoiceOnomatopoeic,
+	"expr.":         VoiceExpressive,
+	"expresiva":     VoiceExpressive,
+}
+
+var (
+	// etymologyLink splits a chain such as "Del fr. chef, y este del lat.
+	// caput" into its steps.
+	etymologyLink = regexp.MustCompile(
+		`[,;]\s*(?:y\s+)?(?:este|esta|esto|estos|estas)\s+(?:(?:der\.|deriv\.)\s+)?(?:del|de la|de)\s+`,
+	)
+	etymologyLead  = regexp.MustCompile(`^(?:Del|De la|De|Der\. de|del|de)\s+`)
+	etymologyGloss = regexp.MustCompile(`['‘"«]([^'’"»]+)['’"»]`)
+)
+
+// ParseEtymology turns a DLE origin such as "Del ár. hisp. *addáwla, y este
+// del ár. clás. dawlah 'dinastía'." into an ordered chain of steps.
+func ParseEtymology(raw string) Etymology {
+	ety := Etymology{Raw: raw}
+
+	text := strings.TrimSpace(raw)
+	if text == "" {
+		return ety
+	}
+
+	if rest, ok := strings.CutPrefix(text, "Voz "); ok {
+		if voice, ok := originVoices[firstField(rest)]; ok {
+			ety.Voice = voice
+			ety.Parsed = true
+		}
+		return ety
+	}
+
+	// "De or. inc.", "De origen incierto" or "De or. onomat."
+	for _, prefix := range []string{"De or. ", "De origen "} {
+		if rest, ok := strings.CutPrefix(text, prefix); ok {
+			if voice, ok := originVoices[firstField(rest)]; ok {
+				ety.Voice = voice
+			} else {
+				ety.Uncertain = true
+			}
+			ety.Parsed = true
+			return ety
+		}
+	}
+
+	text = strings.TrimSuffix(text, ".")
+
+	for i, segment := range etymologyLink.Split(text, -1) {
+		if i == 0 {
+			lead := etymologyLead.FindString(segment)
+			if lead == "" {
+				return Etymology{Raw: raw}
+			}
+			segment = segment[len(lead):]
+		}
+
+		step, ok := parseEtymologyStep(segment)
+		if !ok {
+			return Etymology{Raw: raw}
+		}
+
+		ety.Chain = append(ety.Chain, step)
+	}
+
+	ety.Parsed = true
+
+	return ety
+}
+
+func parseEtymologyStep(segment string) (EtymologyStep, bool) {
+	var step EtymologyStep
+
+	tokens := strings.Fields(segment)
+	if len(tokens) == 0 {
+		return step, false
+	}
+
+	i := 0
+	if lang, ok := originLanguages[strings.ToLower(tokens[0])]; ok {
+		step.Language, step.Abbr = lang, tokens[0]
+		i = 1
+	} else {
+		// No language means the word derives from another Spanish word,
+		// as in "De hablar".
+		step.Language = OriginSpanish
+	}
+
+	var varieties []string
+	for i < len(tokens) && originVarieties[tokens[i]] {
+		varieties = append(varieties, tokens[i])
+		i++
+	}
+	step.Variety = strings.Join(varieties, " ")
+
+	rest := strings.Join(tokens[i:], " ")
+	if loc := etymologyGloss.FindStringSubmatchIndex(rest); loc != nil {
+		step.Gloss = rest[loc[2]:loc[3]]
+		rest = rest[:loc[0]]
+	}
+	step.Etymon = strings.TrimRight(strings.TrimSpace(rest), ",;")
+
+	// A Spanish etymon is a single word, as in "De hablar". Anything longer
+	// is free text such as "De la misma raíz que hablar".
+	if step.Language == OriginSpanish && len(strings.Fields(step.Etymon)) != 1 {
+		return step, false
+	}
+
+	return step, true
+}
+
+func firstField(s string) string {
+	fields := strings.Fields(s)
+	if len(fields) == 0 {
+		return ""
+	}
+	return strings.TrimRight(fields[0], ",;")
+}
+
+// Etymology parses the raw origin of the meaning.
+func (o Origin) Etymology() Etymology {
+	return ParseEtymology(o.Raw)
+}
+
+func (o *Origin) fillFromRaw() {
+	if o.Type != "" && o.Voice != "" {
+		return
+	}
+
+	ety := o.Etymology()
+
+	if o.Type == "" {
+		switch {
+		case len(ety.Chain) > 0:
+			o.Type = ety.Chain[0].Language
+		case ety.Uncertain:
+			o.Type = OriginUncertain
+		}
+	}
+	if o.Voice == "" {
+		o.Voice = ety.Voice
+	}
+}
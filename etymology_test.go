@@ -0,0 +1,80 @@
+package rae
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseEtymology(t *testing.T) {
+	tests := []struct {
+		raw  string
+		want Etymology
+	}{
+		{
+			raw: "Del lat. comedĕre.",
+			want: Etymology{
+				Chain: []EtymologyStep{
+					{Language: OriginLatin, Abbr: "lat.", Etymon: "comedĕre"},
+				},
+				Parsed: true,
+			},
+		},
+		{
+			raw: "Del ár. hisp. *addáwla, y este del ár. clás. dawlah 'dinastía'.",
+			want: Etymology{
+				Chain: []EtymologyStep{
+					{Language: OriginArabic, Abbr: "ár.", Variety: "hisp.", Etymon: "*addáwla"},
+					{
+						Language: OriginArabic,
+						Abbr:     "ár.",
+						Variety:  "clás.",
+						Etymon:   "dawlah",
+						Gloss:    "dinastía",
+					},
+				},
+				Parsed: true,
+			},
+		},
+		{
+			raw: "Del náhuatl xocolatl.",
+			want: Etymology{
+				Chain: []EtymologyStep{
+					{Language: OriginNahuatl, Abbr: "náhuatl", Etymon: "xocolatl"},
+				},
+				Parsed: true,
+			},
+		},
+		{
+			raw: "De hablar.",
+			want: Etymology{
+				Chain:  []EtymologyStep{{Language: OriginSpanish, Etymon: "hablar"}},
+				Parsed: true,
+			},
+		},
+		{
+			raw:  "De or. inc.",
+			want: Etymology{Uncertain: true, Parsed: true},
+		},
+		{
+			raw:  "Voz onomat.",
+			want: Etymology{Voice: VoiceOnomatopoeic, Parsed: true},
+		},
+		{
+			raw:  "Cf. otra cosa distinta",
+			want: Etymology{},
+		},
+		{
+			raw:  "De la misma raíz que hablar.",
+			want: Etymology{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.raw, func(t *testing.T) {
+			tt.want.Raw = tt.raw
+			if got := ParseEtymology(tt.raw); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("want %+v, got %+v", tt.want, got)
+			}
+		})
+	}
+}
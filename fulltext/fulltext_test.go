@@ -0,0 +1,182 @@
+package fulltext
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"testing"
+
+	rae "github.com/rae-api-com/go-rae"
+	"github.com/rae-api-com/go-rae/raetest"
+)
+
+func testIndex(t *testing.T) *Index {
+	t.Helper()
+
+	entries := append(raetest.Entries(), rae.WordEntry{
+		Word: "casamiento",
+		Meanings: []rae.Meaning{{
+			Definitions: []rae.Definition{{
+				MeaningNumber: 1,
+				Category:      rae.CategoryNoun,
+				Usage:         rae.UsageCommon,
+				Description:   "Acción y efecto de casar o casarse.",
+				Synonyms:      []string{"boda", "matrimonio"},
+			}},
+			Locutions: []rae.Locution{{
+				Text:       "casamiento a juras",
+				Definition: "Matrimonio clandestino.",
+			}},
+		}},
+	})
+
+	idx, err := NewIndex(entries...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return idx
+}
+
+func search(t *testing.T, idx *Index, q Query) []string {
+	t.Helper()
+
+	page, err := idx.Query(context.Background(), q)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var words []string
+	for _, r := range page.Results {
+		words = append(words, fmt.Sprintf("%s:%d", r.Doc.Word, r.Hits))
+	}
+	return words
+}
+
+func TestQuery(t *testing.T) {
+	idx := testIndex(t)
+
+	tests := []struct {
+		name string
+		q    Query
+		want []string
+	}{
+		{"headword", Query{Text: "casa"}, []string{"casa:1"}},
+		{"accents", Query{Text: "CAMION"}, []string{"camión:1"}},
+		{"stemmed", Query{Text: "palabra idiomas"}, []string{"hablar:2"}},
+		{"description", Query{Text: "entender"}, []string{"hablar:2"}},
+		{"all words", Query{Text: "proferir entender"}, []string{"hablar:3"}},
+		{"synonym", Query{Text: "boda"}, []string{"casamiento:1"}},
+		{"locution", Query{Text: "clandestino"}, []string{"casamiento:1"}},
+		{"phrase", Query{Text: `"darse a entender"`}, []string{"hablar:2"}},
+		{"phrase order", Query{Text: `"entender darse"`}, nil},
+		{"phrase across fields", Query{Text: `"juras matrimonio"`}, nil},
+		{"prefix", Query{Text: "cas*"}, []string{"casamiento:4", "casa:1"}},
+		{"prefix and word", Query{Text: "cas* boda"}, []string{"casamiento:5"}},
+		{"stop words only", Query{Text: "de la"}, nil},
+	}
+
+	for _, tt := range tests {
+		if got := search(t, idx, tt.q); !slices.Equal(got, tt.want) {
+			t.Errorf("%s: got %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestQueryFilters(t *testing.T) {
+	idx := testIndex(t)
+
+	tests := []struct {
+		name string
+		q    Query
+		want []string
+	}{
+		{"category", Query{Categories: []rae.WordCategory{rae.CategoryVerb}}, []string{"hablar:0"}},
+		{"gender", Query{Genders: []rae.Gender{rae.GenderFeminine}}, []string{"casa:0"}},
+		{"region", Query{Text: "autobús", Regions: []string{"MX"}}, []string{"camión:2"}},
+		{"region excluded", Query{Text: "autobús", Regions: []string{"ES"}}, nil},
+		{"text and category", Query{Text: "cas*", Categories: []rae.WordCategory{rae.CategoryNoun}, Usages: []rae.Usage{rae.UsageCommon}}, []string{"casamiento:4", "casa:1"}},
+	}
+
+	for _, tt := range tests {
+		if got := search(t, idx, tt.q); !slices.Equal(got, tt.want) {
+			t.Errorf("%s: got %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestPagination(t *testing.T) {
+	idx := testIndex(t)
+
+	q := Query{Categories: []rae.WordCategory{rae.CategoryNoun, rae.CategoryVerb}, Limit: 2}
+	page, err := idx.Query(context.Background(), q)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if page.Total != 4 || len(page.Results) != 2 || !page.More() {
+		t.Fatalf("first page: %+v", page)
+	}
+
+	q.Cursor = page.NextCursor
+	page, _ = idx.Query(context.Background(), q)
+	if page.Offset != 2 || len(page.Results) != 2 || page.More() || page.Results[1].Doc.Word != "hablar" {
+		t.Fatalf("second page: %+v", page)
+	}
+	q.Cursor = ""
+
+	if _, err := idx.Query(context.Background(), Query{Text: "casa", Cursor: "next"}); err == nil {
+		t.Error("expected an error for an invalid cursor")
+	}
+
+	q.Offset = 10
+	if page, _ = idx.Query(context.Background(), q); len(page.Results) != 0 {
+		t.Fatalf("past the end: %+v", page)
+	}
+}
+
+func TestSearchIsDropIn(t *testing.T) {
+	idx := testIndex(t)
+
+	results, err := idx.Search(context.Background(), "vehículo")
+	if err != nil || len(results) != 1 {
+		t.Fatalf("%v, %v", results, err)
+	}
+
+	entry, err := results[0].WordEntry()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if entry.Word != "camión" || len(entry.Meanings[0].Definitions) != 2 {
+		t.Errorf("decoded %+v", entry)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := idx.Search(ctx, "casa"); err == nil {
+		t.Error("cancelled context should fail")
+	}
+}
+
+func TestQueryRegionFromRaw(t *testing.T) {
+	guagua := rae.WordEntry{
+		Word: "guagua",
+		Meanings: []rae.Meaning{{
+			Definitions: []rae.Definition{{
+				Raw:           "1. f. Ant. Autobús.",
+				MeaningNumber: 1,
+				Category:      rae.CategoryNoun,
+				Description:   "Autobús.",
+			}},
+		}},
+	}
+
+	idx, err := NewIndex(guagua)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got := search(t, idx, Query{Text: "autobús", Regions: []string{"CU"}}); !slices.Equal(got, []string{"guagua:1"}) {
+		t.Errorf("got %v, want the region parsed from the raw definition", got)
+	}
+	if guagua.Meanings[0].Definitions[0].Regions != nil {
+		t.Error("the entry given to Add was changed")
+	}
+}
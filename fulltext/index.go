@@ -0,0 +1,281 @@
+// Package fulltext searches a local set of entries, such as a snapshot, by
+// headword, definitions, synonyms and locutions. It works offline and
+// returns the same rae.SearchResult as the remote search.
+package fulltext
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	rae "github.com/rae-api-com/go-rae"
+	"github.com/rae-api-com/go-rae/analysis"
+	"github.com/rae-api-com/go-rae/collate"
+)
+
+// fieldGap separates the positions of the texts of an entry, so that
+// phrases do not match across a definition and the next one.
+const fieldGap = 1000
+
+type document struct {
+	word  string
+	raw   string
+	entry rae.WordEntry
+}
+
+// posting lists the positions of a term in a document.
+type posting struct {
+	doc       int
+	positions []int
+}
+
+// Index is an inverted index over entries. It is safe for concurrent use.
+type Index struct {
+	mu    sync.RWMutex
+	docs  []document
+	words map[string]bool
+
+	// terms holds the stems of the indexed words and surface their folded
+	// forms, for prefix queries over the sorted vocab.
+	terms   map[string][]posting
+	surface map[string][]posting
+	vocab   []string
+}
+
+// NewIndex returns an index over entries.
+func NewIndex(entries ...rae.WordEntry) (*Index, error) {
+	idx := &Index{}
+	if err := idx.Add(entries...); err != nil {
+		return nil, err
+	}
+	return idx, nil
+}
+
+// Add indexes entries, enriched as by rae.WordEntry.Enrich so that the
+// filters see the regions found only in the raw definitions. Entries whose
+// headword is already in the index are skipped.
+func (idx *Index) Add(entries ...rae.WordEntry) error {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+
+	if idx.words == nil {
+		idx.words = map[string]bool{}
+		idx.terms = map[string][]posting{}
+		idx.surface = map[string][]posting{}
+	}
+
+	added := false
+	for _, e := range entries {
+		if idx.words[e.Word] {
+			continue
+		}
+
+		e = e.Clone()
+		e.Enrich()
+
+		raw, err := json.Marshal(e)
+		if err != nil {
+			return err
+		}
+
+		idx.words[e.Word] = true
+		id := len(idx.docs)
+		idx.docs = append(idx.docs, document{word: e.Word, raw: string(raw), entry: e})
+
+		terms := map[string][]int{}
+		surface := map[string][]int{}
+		for i, text := range texts(e) {
+			for j, t := range analysis.Tokenize(text) {
+				if analysis.IsStopWord(t.Term) {
+					continue
+				}
+				pos := i*fieldGap + j
+				terms[analysis.Stem(t.Term)] = append(terms[analysis.Stem(t.Term)], pos)
+				surface[t.Term] = append(surface[t.Term], pos)
+			}
+		}
+
+		for t, positions := range terms {
+			idx.terms[t] = append(idx.terms[t], posting{doc: id, positions: positions})
+		}
+		for t, positions := range surface {
+			if _, ok := idx.surface[t]; !ok {
+				idx.vocab = append(idx.vocab, t)
+			}
+			idx.surface[t] = append(idx.surface[t], posting{doc: id, positions: positions})
+		}
+		added = true
+	}
+
+	if added {
+		sort.Strings(idx.vocab)
+	}
+
+	return nil
+}
+
+// texts returns the searchable texts of an entry.
+func texts(e rae.WordEntry) []string {
+	out := []string{e.Word}
+	for _, m := range e.Meanings {
+		for _, d := range m.Definitions {
+			out = append(out, d.Description)
+			out = append(out, d.Synonyms...)
+		}
+		for _, l := range m.Locutions {
+			out = append(out, l.Text, l.Definition)
+		}
+	}
+	return out
+}
+
+// Len returns the number of entries in the index.
+func (idx *Index) Len() int {
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+	return len(idx.docs)
+}
+
+// Query returns the entries matching q, those with the most hits first.
+// Hits counts the occurrences of the words, phrases and prefixes of
+// q.Text in the entry. A query with filters but no text matches every
+// entry passing the filters, in dictionary order.
+func (idx *Index) Query(ctx context.Context, q Query) (rae.SearchPage, error) {
+	limit := q.Limit
+	if limit <= 0 {
+		limit = 10
+	}
+	offset, err := q.offset()
+	if err != nil {
+		return rae.SearchPage{}, err
+	}
+
+	clauses := parseText(q.Text)
+	if len(clauses) == 0 && !q.filtered() {
+		return rae.SearchPage{Offset: offset}, nil
+	}
+
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+
+	var hits map[int]int
+	if len(clauses) == 0 {
+		hits = make(map[int]int, len(idx.docs))
+		for i := range idx.docs {
+			hits[i] = 0
+		}
+	}
+
+	for _, c := range clauses {
+		if err := ctx.Err(); err != nil {
+			return rae.SearchPage{}, err
+		}
+
+		counts := idx.match(c)
+		if hits == nil {
+			hits = counts
+			continue
+		}
+		for doc, n := range hits {
+			if m, ok := counts[doc]; ok {
+				hits[doc] = n + m
+			} else {
+				delete(hits, doc)
+			}
+		}
+	}
+
+	var results []rae.SearchResult
+	for doc, n := range hits {
+		d := idx.docs[doc]
+		if q.filtered() && !q.matches(d.entry) {
+			continue
+		}
+
+		var r rae.SearchResult
+		r.Doc.Word, r.Doc.Raw, r.Hits = d.word, d.raw, n
+		results = append(results, r)
+	}
+
+	sort.Slice(results, func(i, j int) bool {
+		if results[i].Hits != results[j].Hits {
+			return results[i].Hits > results[j].Hits
+		}
+		return collate.Compare(results[i].Doc.Word, results[j].Doc.Word) < 0
+	})
+
+	page := rae.SearchPage{Total: len(results), Offset: offset}
+	if offset < len(results) {
+		end := min(offset+limit, len(results))
+		page.Results = results[offset:end]
+		if end < len(results) {
+			page.NextCursor = strconv.Itoa(end)
+		}
+	}
+
+	return page, nil
+}
+
+// Search runs Query{Text: terms} and returns its first page, like
+// rae.Client.Search does remotely.
+func (idx *Index) Search(ctx context.Context, terms string) ([]rae.SearchResult, error) {
+	page, err := idx.Query(ctx, Query{Text: terms})
+	return page.Results, err
+}
+
+// match returns the number of occurrences of c in every document where it
+// occurs.
+func (idx *Index) match(c clause) map[int]int {
+	counts := map[int]int{}
+
+	switch c.kind {
+	case clauseTerm:
+		for _, p := range idx.terms[c.terms[0]] {
+			counts[p.doc] += len(p.positions)
+		}
+
+	case clausePrefix:
+		prefix := c.terms[0]
+		for i := sort.SearchStrings(idx.vocab, prefix); i < len(idx.vocab); i++ {
+			if !strings.HasPrefix(idx.vocab[i], prefix) {
+				break
+			}
+			for _, p := range idx.surface[idx.vocab[i]] {
+				counts[p.doc] += len(p.positions)
+			}
+		}
+
+	case clausePhrase:
+		// positions of every term of the phrase, by document
+		byDoc := make([]map[int][]int, len(c.terms))
+		for i, t := range c.terms {
+			byDoc[i] = map[int][]int{}
+			for _, p := range idx.terms[t] {
+				byDoc[i][p.doc] = p.positions
+			}
+		}
+
+		for doc, starts := range byDoc[0] {
+		start:
+			for _, start := range starts {
+				for i := 1; i < len(c.terms); i++ {
+					if !containsInt(byDoc[i][doc], start+c.offsets[i]) {
+						continue start
+					}
+				}
+				counts[doc]++
+			}
+		}
+	}
+
+	return counts
+}
+
+// containsInt reports whether the sorted positions contain p.
+func containsInt(positions []int, p int) bool {
+	i := sort.SearchInts(positions, p)
+	return i < len(positions) && positions[i] == p
+}
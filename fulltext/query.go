@@ -0,0 +1,142 @@
+package fulltext
+
+import (
+	"strconv"
+	"strings"
+
+	rae "github.com/rae-api-com/go-rae"
+	"github.com/rae-api-com/go-rae/analysis"
+	"github.com/rae-api-com/go-rae/collate"
+)
+
+// Query is a search over an Index. Text holds space separated words, which
+// must all appear in an entry, "quoted phrases", whose words must appear in
+// that order, and prefixes ending in "*", such as "cas*". The filters keep
+// the entries with a sense that matches all of them; every filter matches
+// any of its values.
+type Query struct {
+	Text       string
+	Categories []rae.WordCategory
+	Usages     []rae.Usage
+	Genders    []rae.Gender
+	Regions    []string // ISO codes, see rae.Region.Covers
+
+	// Offset and Limit select a page of the results. Limit is 10 when zero.
+	// Cursor, when set, is the NextCursor of the previous page and takes
+	// the place of Offset.
+	Offset int
+	Limit  int
+	Cursor string
+}
+
+type clauseKind int
+
+const (
+	clauseTerm clauseKind = iota
+	clausePhrase
+	clausePrefix
+)
+
+// clause is a part of Query.Text. Phrases keep the position of every term
+// relative to the first one, counting the stop words left out.
+type clause struct {
+	kind    clauseKind
+	terms   []string
+	offsets []int
+}
+
+func parseText(text string) []clause {
+	var clauses []clause
+
+	for len(text) > 0 {
+		text = strings.TrimLeft(text, " \t\n")
+		if text == "" {
+			break
+		}
+
+		if text[0] == '"' {
+			phrase, rest, _ := strings.Cut(text[1:], `"`)
+			text = rest
+			if c, ok := phraseClause(phrase); ok {
+				clauses = append(clauses, c)
+			}
+			continue
+		}
+
+		word, rest, _ := strings.Cut(text, " ")
+		text = rest
+
+		if prefix, ok := strings.CutSuffix(word, "*"); ok {
+			if key := collate.Key(prefix); key != "" {
+				clauses = append(clauses, clause{kind: clausePrefix, terms: []string{key}})
+			}
+			continue
+		}
+
+		for _, t := range analysis.Analyze(word) {
+			clauses = append(clauses, clause{kind: clauseTerm, terms: []string{t.Term}})
+		}
+	}
+
+	return clauses
+}
+
+func phraseClause(phrase string) (clause, bool) {
+	c := clause{kind: clausePhrase}
+
+	first := -1
+	for i, t := range analysis.Tokenize(phrase) {
+		if analysis.IsStopWord(t.Term) {
+			continue
+		}
+		if first < 0 {
+			first = i
+		}
+		c.terms = append(c.terms, analysis.Stem(t.Term))
+		c.offsets = append(c.offsets, i-first)
+	}
+
+	switch len(c.terms) {
+	case 0:
+		return clause{}, false
+	case 1:
+		c.kind = clauseTerm
+	}
+	return c, true
+}
+
+// offset returns the offset of the requested page. The cursors of an index
+// hold the offset of the next page.
+func (q Query) offset() (int, error) {
+	if q.Cursor == "" {
+		return max(q.Offset, 0), nil
+	}
+	n, err := strconv.Atoi(q.Cursor)
+	if err != nil || n < 0 {
+		return 0, &rae.InputError{Kind: "cursor", Input: q.Cursor, Reason: "not a cursor of this index"}
+	}
+	return n, nil
+}
+
+func (q Query) filtered() bool {
+	return len(q.Categories)+len(q.Usages)+len(q.Genders)+len(q.Regions) > 0
+}
+
+// matches reports whether a sense of e passes the filters of q.
+func (q Query) matches(e rae.WordEntry) bool {
+	f := e.Senses()
+	if len(q.Categories) > 0 {
+		f = f.Category(q.Categories...)
+	}
+	if len(q.Usages) > 0 {
+		f = f.Usage(q.Usages...)
+	}
+	if len(q.Genders) > 0 {
+		f = f.Gender(q.Genders...)
+	}
+	if len(q.Regions) > 0 {
+		f = f.Region(q.Regions...)
+	}
+	_, ok := f.First()
+	return ok
+}
@@ -9,6 +9,7 @@ require (
 	github.com/pkg/errors v0.9.1
 	github.com/sonirico/vago v0.9.0
 	github.com/sonirico/withttp v0.9.0
+	golang.org/x/text v0.28.0
 )
 
 require (
@@ -0,0 +1,437 @@
+package rae
+
+import (
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// LabelKind classifies an abbreviation found in the label block of a
+// definition, e.g. "tr." is a verb category and "coloq." a usage mark.
+type LabelKind string
+
+const (
+	LabelCategory     LabelKind = "category"
+	LabelVerbCategory LabelKind = "verb_category"
+	LabelGender       LabelKind = "gender"
+	LabelUsage        LabelKind = "usage"
+	LabelRegion       LabelKind = "region"
+	LabelDomain       LabelKind = "domain"
+)
+
+// Label is a single typed label. Abbr keeps the abbreviation as written in
+// the DLE, Value holds the normalised value (e.g. "verb", "transitive").
+type Label struct {
+	Kind  LabelKind `json:"kind"`
+	Abbr  string    `json:"abbr"`
+	Value string    `json:"value"`
+}
+
+// RawLabels is the result of parsing the leading label block of
+// Definition.Raw. Head is set for the senses of multi-word expressions,
+// whose raw text starts with the expression itself ("a la buena de Dios.
+// 1. loc. adv. ...").
+type RawLabels struct {
+	Head    string   `json:"head,omitempty"`
+	Number  int      `json:"number"`
+	Labels  []Label  `json:"labels"`
+	Unknown []string `json:"unknown,omitempty"`
+	Text    string   `json:"text"`
+}
+
+// maxHeadTokens bounds the length of the expression that may precede the
+// sense number, so that numbers inside a description are not mistaken for
+// one.
+const maxHeadTokens = 10
+
+// maxLabelTokens is the longest abbreviation in labelTable measured in
+// whitespace separated tokens ("m. y f.", "EE. UU.").
+const maxLabelTokens = 3
+
+var labelTable = map[string][]Label{
+	// Categories
+	"adj.":    {{Kind: LabelCategory, Value: string(CategoryAdjective)}},
+	"adv.":    {{Kind: LabelCategory, Value: string(CategoryAdverb)}},
+	"art.":    {{Kind: LabelCategory, Value: string(CategoryArticle)}},
+	"conj.":   {{Kind: LabelCategory, Value: string(CategoryConjunction)}},
+	"interj.": {{Kind: LabelCategory, Value: string(CategoryInterjection)}},
+	"prep.":   {{Kind: LabelCategory, Value: string(CategoryPreposition)}},
+	"pron.":   {{Kind: LabelCategory, Value: string(CategoryPronoun)}},
+	"s.":      {{Kind: LabelCategory, Value: string(CategoryNoun)}},
+	"sust.":   {{Kind: LabelCategory, Value: string(CategoryNoun)}},
+	"v.":      {{Kind: LabelCategory, Value: string(CategoryVerb)}},
+
+	// Locutions carry the category of the phrase they form
+	"loc. adj.":    {{Kind: LabelCategory, Value: string(CategoryAdjective)}},
+	"loc. adv.":    {{Kind: LabelCategory, Value: string(CategoryAdverb)}},
+	"loc. conj.":   {{Kind: LabelCategory, Value: string(CategoryConjunction)}},
+	"loc. interj.": {{Kind: LabelCategory, Value: string(CategoryInterjection)}},
+	"loc. prep.":   {{Kind: LabelCategory, Value: string(CategoryPreposition)}},
+	"loc. pron.":   {{Kind: LabelCategory, Value: string(CategoryPronoun)}},
+	"loc. s.":      {{Kind: LabelCategory, Value: string(CategoryNoun)}},
+	"loc. sust.":   {{Kind: LabelCategory, Value: string(CategoryNoun)}},
+	"loc. verb.":   {{Kind: LabelCategory, Value: string(CategoryVerb)}},
+
+	// Nouns are labelled by their gender
+	"m.": {
+		{Kind: LabelCategory, Value: string(CategoryNoun)},
+		{Kind: LabelGender, Value: string(GenderMasculine)},
+	},
+	"f.": {
+		{Kind: LabelCategory, Value: string(CategoryNoun)},
+		{Kind: LabelGender, Value: string(GenderFeminine)},
+	},
+	"m. y f.": {
+		{Kind: LabelCategory, Value: string(CategoryNoun)},
+		{Kind: LabelGender, Value: string(GenderBoth)},
+	},
+	"f. y m.": {
+		{Kind: LabelCategory, Value: string(CategoryNoun)},
+		{Kind: LabelGender, Value: string(GenderBoth)},
+	},
+	"com.": {
+		{Kind: LabelCategory, Value: string(CategoryNoun)},
+		{Kind: LabelGender, Value: string(GenderBoth)},
+	},
+
+	// Verbs are labelled by their verb category
+	"tr.": {
+		{Kind: LabelCategory, Value: string(CategoryVerb)},
+		{Kind: LabelVerbCategory, Value: string(VerbCategoryTransitive)},
+	},
+	"intr.": {
+		{Kind: LabelCategory, Value: string(CategoryVerb)},
+		{Kind: LabelVerbCategory, Value: string(VerbCategoryIntransitive)},
+	},
+	"prnl.": {
+		{Kind: LabelCategory, Value: string(CategoryVerb)},
+		{Kind: LabelVerbCategory, Value: string(VerbCategoryPronominal)},
+	},
+	"cop.": {
+		{Kind: LabelCategory, Value: string(CategoryVerb)},
+		{Kind: LabelVerbCategory, Value: string(VerbCategoryCopulative)},
+	},
+	"aux.": {
+		{Kind: LabelCategory, Value: string(CategoryVerb)},
+		{Kind: LabelVerbCategory, Value: string(VerbCategoryAuxiliary)},
+	},
+	"defect.": {
+		{Kind: LabelCategory, Value: string(CategoryVerb)},
+		{Kind: LabelVerbCategory, Value: string(VerbCategoryDefective)},
+	},
+
+	// Usage marks
+	"coloq.": {{Kind: LabelUsage, Value: string(UsageColloquial)}},
+	"desus.": {{Kind: LabelUsage, Value: string(UsageObsolete)}},
+	"p. us.": {{Kind: LabelUsage, Value: string(UsageRare)}},
+	"ant.":   {{Kind: LabelUsage, Value: string(UsageOutdated)}},
+
+	// Regions
+	"Am.":      {{Kind: LabelRegion, Value: string(RegionAmerica)}},
+	"Am. Cen.": {{Kind: LabelRegion, Value: string(RegionCentralAmerica)}},
+	"Am. Mer.": {{Kind: LabelRegion, Value: string(RegionSouthAmerica)}},
+	"And.":     {{Kind: LabelRegion, Value: string(RegionAndalusia)}},
+	"Ant.":     {{Kind: LabelRegion, Value: string(RegionAntilles)}},
+	"Arg.":     {{Kind: LabelRegion, Value: string(RegionArgentina)}},
+	"Bol.":     {{Kind: LabelRegion, Value: string(RegionBolivia)}},
+	"C. Rica":  {{Kind: LabelRegion, Value: string(RegionCostaRica)}},
+	"Can.":     {{Kind: LabelRegion, Value: string(RegionCanaryIslands)}},
+	"Chile":    {{Kind: LabelRegion, Value: string(RegionChile)}},
+	"Col.":     {{Kind: LabelRegion, Value: string(RegionColombia)}},
+	"Cuba":     {{Kind: LabelRegion, Value: string(RegionCuba)}},
+	"EE. UU.":  {{Kind: LabelRegion, Value: string(RegionUnitedStates)}},
+	"Ec.":      {{Kind: LabelRegion, Value: string(RegionEcuador)}},
+	"El Salv.": {{Kind: LabelRegion, Value: string(RegionElSalvador)}},
+	"Esp.":     {{Kind: LabelRegion, Value: string(RegionSpain)}},
+	"Filip.":   {{Kind: LabelRegion, Value: string(RegionPhilippines)}},
+	"Guat.":    {{Kind: LabelRegion, Value: string(RegionGuatemala)}},
+	"Guin.":    {{Kind: LabelRegion, Value: string(RegionEquatorialGuinea)}},
+	"Hond.":    {{Kind: LabelRegion, Value: string(RegionHonduras)}},
+	"Méx.":     {{Kind: LabelRegion, Value: string(RegionMexico)}},
+	"Nic.":     {{Kind: LabelRegion, Value: string(RegionNicaragua)}},
+	"P. Rico":  {{Kind: LabelRegion, Value: string(RegionPuertoRico)}},
+	"Pan.":     {{Kind: LabelRegion, Value: string(RegionPanama)}},
+	"Par.":     {{Kind: LabelRegion, Value: string(RegionParaguay)}},
+	"Perú":     {{Kind: LabelRegion, Value: string(RegionPeru)}},
+	"R. Dom.":  {{Kind: LabelRegion, Value: string(RegionDominicanRepublic)}},
+	"Ur.":      {{Kind: LabelRegion, Value: string(RegionUruguay)}},
+	"Ven.":     {{Kind: LabelRegion, Value: string(RegionVenezuela)}},
+
+	// Domains
+	"Agr.":    {{Kind: LabelDomain, Value: string(DomainAgriculture)}},
+	"Anat.":   {{Kind: LabelDomain, Value: string(DomainAnatomy)}},
+	"Arq.":    {{Kind: LabelDomain, Value: string(DomainArchitecture)}},
+	"Astron.": {{Kind: LabelDomain, Value: string(DomainAstronomy)}},
+	"Biol.":   {{Kind: LabelDomain, Value: string(DomainBiology)}},
+	"Bot.":    {{Kind: LabelDomain, Value: string(DomainBotany)}},
+	"Com.":    {{Kind: LabelDomain, Value: string(DomainCommerce)}},
+	"Dep.":    {{Kind: LabelDomain, Value: string(DomainSports)}},
+	"Der.":    {{Kind: LabelDomain, Value: string(DomainLaw)}},
+	"Econ.":   {{Kind: LabelDomain, Value: string(DomainEconomics)}},
+	"Electr.": {{Kind: LabelDomain, Value: string(DomainElectricity)}},
+	"Fil.":    {{Kind: LabelDomain, Value: string(DomainPhilosophy)}},
+	"Fís.":    {{Kind: LabelDomain, Value: string(DomainPhysics)}},
+	"Geogr.":  {{Kind: LabelDomain, Value: string(DomainGeography)}},
+	"Geol.":   {{Kind: LabelDomain, Value: string(DomainGeology)}},
+	"Geom.":   {{Kind: LabelDomain, Value: string(DomainGeometry)}},
+	"Gram.":   {{Kind: LabelDomain, Value: string(DomainGrammar)}},
+	"Inform.": {{Kind: LabelDomain, Value: string(DomainComputing)}},
+	"Ling.":   {{Kind: LabelDomain, Value: string(DomainLinguistics)}},
+	"Mar.":    {{Kind: LabelDomain, Value: string(DomainNautical)}},
+	"Mat.":    {{Kind: LabelDomain, Value: string(DomainMathematics)}},
+	"Mec.":    {{Kind: LabelDomain, Value: string(DomainMechanics)}},
+	"Med.":    {{Kind: LabelDomain, Value: string(DomainMedicine)}},
+	"Mil.":    {{Kind: LabelDomain, Value: string(DomainMilitary)}},
+	"Mús.":    {{Kind: LabelDomain, Value: string(DomainMusic)}},
+	"Psicol.": {{Kind: LabelDomain, Value: string(DomainPsychology)}},
+	"Quím.":   {{Kind: LabelDomain, Value: string(DomainChemistry)}},
+	"Rel.":    {{Kind: LabelDomain, Value: string(DomainReligion)}},
+	"Taurom.": {{Kind: LabelDomain, Value: string(DomainBullfighting)}},
+	"Zool.":   {{Kind: LabelDomain, Value: string(DomainZoology)}},
+	"Heráld.": {{Kind: LabelDomain, Value: string(DomainHeraldry)}},
+	"Impr.":   {{Kind: LabelDomain, Value: string(DomainPrinting)}},
+	"Pint.":   {{Kind: LabelDomain, Value: string(DomainPainting)}},
+	"Ópt.":    {{Kind: LabelDomain, Value: string(DomainOptics)}},
+	"Fon.":    {{Kind: LabelDomain, Value: string(DomainPhonetics)}},
+	"Ret.":    {{Kind: LabelDomain, Value: string(DomainRhetoric)}},
+	"Cineg.":  {{Kind: LabelDomain, Value: string(DomainHunting)}},
+	"Equit.":  {{Kind: LabelDomain, Value: string(DomainHorsemanship)}},
+	"Fisiol.": {{Kind: LabelDomain, Value: string(DomainPhysiology)}},
+	"Lit.":    {{Kind: LabelDomain, Value: string(DomainLiterature)}},
+	"Métr.":   {{Kind: LabelDomain, Value: string(DomainMetrics)}},
+	"Teatro":  {{Kind: LabelDomain, Value: string(DomainTheatre)}},
+	"Cinem.":  {{Kind: LabelDomain, Value: string(DomainCinema)}},
+	"Mit.":    {{Kind: LabelDomain, Value: string(DomainMythology)}},
+	"Transp.": {{Kind: LabelDomain, Value: string(DomainTransport)}},
+	"Veter.":  {{Kind: LabelDomain, Value: string(DomainVeterinary)}},
+}
+
+// ParseRaw tokenises the leading label block of a DLE definition line such
+// as "1. tr. coloq. Comer deprisa." into typed labels. Tokens that look like
+// abbreviations but are not known are reported in Unknown; parsing stops at
+// the first token that is not an abbreviation, which starts Text.
+func ParseRaw(raw string) RawLabels {
+	var out RawLabels
+
+	tokens := strings.Fields(raw)
+	i := 0
+
+	if i = headLength(tokens); i > 0 {
+		out.Head = strings.TrimSuffix(strings.Join(tokens[:i], " "), ".")
+	}
+
+	if i < len(tokens) {
+		if n, ok := parseSenseNumber(tokens[i]); ok {
+			out.Number = n
+			i++
+		}
+	}
+
+	for i < len(tokens) {
+		if n, labels, ok := lookupLabel(tokens[i:]); ok {
+			out.Labels = append(out.Labels, labels...)
+			i += n
+			continue
+		}
+
+		tok := tokens[i]
+
+		// Connector between two labels, as in "tr. y prnl."
+		if tok == "y" && i+1 < len(tokens) {
+			if _, _, ok := lookupLabel(tokens[i+1:]); ok {
+				i++
+				continue
+			}
+		}
+
+		// An unknown abbreviation followed by more text. A lone trailing
+		// token is most likely a one word description ("Comer.").
+		if isAbbreviation(tok) && !textMarkers[tok] && i+1 < len(tokens) {
+			out.Unknown = append(out.Unknown, tok)
+			i++
+			continue
+		}
+
+		break
+	}
+
+	out.Text = strings.Join(tokens[i:], " ")
+
+	return out
+}
+
+// Values returns the values of every label of the given kind, in order.
+func (r RawLabels) Values(kind LabelKind) []string {
+	var values []string
+	for _, l := range r.Labels {
+		if l.Kind == kind {
+			values = append(values, l.Value)
+		}
+	}
+	return values
+}
+
+// First returns the value of the first label of the given kind.
+func (r RawLabels) First(kind LabelKind) (string, bool) {
+	for _, l := range r.Labels {
+		if l.Kind == kind {
+			return l.Value, true
+		}
+	}
+	return "", false
+}
+
+// Conflicts returns the labels whose value disagrees with the structured
+// field of the definition. Empty structured fields are not conflicts.
+func (r RawLabels) Conflicts(d Definition) []Label {
+	var conflicts []Label
+
+	for _, l := range r.Labels {
+		var current string
+
+		switch l.Kind {
+		case LabelCategory:
+			current = string(d.Category)
+		case LabelVerbCategory:
+			if d.VerbCategory != nil {
+				current = string(*d.VerbCategory)
+			}
+		case LabelGender:
+			if d.Gender != nil {
+				current = string(*d.Gender)
+			}
+		case LabelUsage:
+			current = string(d.Usage)
+			if d.Usage == UsageUnknown {
+				current = ""
+			}
+		default:
+			continue
+		}
+
+		if current != "" && current != l.Value && !r.has(l.Kind, current) {
+			conflicts = append(conflicts, l)
+		}
+	}
+
+	return conflicts
+}
+
+func (r RawLabels) has(kind LabelKind, value string) bool {
+	for _, l := range r.Labels {
+		if l.Kind == kind && l.Value == value {
+			return true
+		}
+	}
+	return false
+}
+
+// FillFromRaw parses Raw and sets Category, VerbCategory, Gender, Usage,
+// Regions and Domains when the API left them empty. The parsed labels are
+// returned so callers can inspect Unknown and Conflicts.
+func (d *Definition) FillFromRaw() RawLabels {
+	labels := ParseRaw(d.Raw)
+
+	if d.MeaningNumber == 0 {
+		d.MeaningNumber = labels.Number
+	}
+	if v, ok := labels.First(LabelCategory); ok && d.Category == "" {
+		d.Category = WordCategory(v)
+	}
+	if v, ok := labels.First(LabelVerbCategory); ok && d.VerbCategory == nil {
+		vc := VerbCategory(v)
+		d.VerbCategory = &vc
+	}
+	if v, ok := labels.First(LabelGender); ok && d.Gender == nil {
+		g := Gender(v)
+		d.Gender = &g
+	}
+	if v, ok := labels.First(LabelUsage); ok && (d.Usage == "" || d.Usage == UsageUnknown) {
+		d.Usage = Usage(v)
+	}
+	if d.Description == "" {
+		d.Description = labels.Text
+	}
+	d.fillScopes(labels)
+
+	return labels
+}
+
+func lookupLabel(tokens []string) (int, []Label, bool) {
+	for n := min(maxLabelTokens, len(tokens)); n > 0; n-- {
+		abbr := strings.Join(tokens[:n], " ")
+		labels, ok := labelTable[abbr]
+		if !ok {
+			// Labels written without a period ("Chile", "P. Rico") take
+			// one when they close the label block.
+			abbr = strings.TrimSuffix(abbr, ".")
+			if labels, ok = labelTable[abbr]; !ok {
+				continue
+			}
+		}
+		out := make([]Label, len(labels))
+		for i, l := range labels {
+			l.Abbr = abbr
+			out[i] = l
+		}
+		return n, out, true
+	}
+	return 0, nil, false
+}
+
+// headLength returns the number of tokens of the expression preceding the
+// sense number, or zero when the raw text starts with the number.
+func headLength(tokens []string) int {
+	if len(tokens) == 0 {
+		return 0
+	}
+	if _, ok := parseSenseNumber(tokens[0]); ok {
+		return 0
+	}
+	for k := 1; k < len(tokens) && k <= maxHeadTokens; k++ {
+		if _, ok := parseSenseNumber(tokens[k]); ok && strings.HasSuffix(tokens[k-1], ".") {
+			return k
+		}
+	}
+	return 0
+}
+
+func parseSenseNumber(tok string) (int, bool) {
+	if !strings.HasSuffix(tok, ".") {
+		return 0, false
+	}
+	n, err := strconv.Atoi(strings.TrimSuffix(tok, "."))
+	if err != nil {
+		return 0, false
+	}
+	return n, true
+}
+
+// textMarkers are abbreviations that start the text of a definition, as in
+// "U. para animar.", rather than label it. "Ant." is left out: before the
+// text it is the Antilles.
+var textMarkers = map[string]bool{
+	"U.":     true, // usado
+	"Ú.":     true,
+	"V.":     true, // véase
+	"Cf.":    true, // confróntese
+	"Abrev.": true,
+	"Sin.":   true,
+	"Sím.":   true,
+}
+
+// isAbbreviation reports whether tok has the shape of a DLE abbreviation:
+// a short run of letters closed by a period.
+func isAbbreviation(tok string) bool {
+	word, ok := strings.CutSuffix(tok, ".")
+	if !ok || word == "" || utf8.RuneCountInString(word) > 8 {
+		return false
+	}
+	for _, r := range word {
+		if !unicode.IsLetter(r) {
+			return false
+		}
+	}
+	return true
+}
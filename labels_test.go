@@ -0,0 +1,140 @@
+package rae
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseRaw(t *testing.T) {
+	tests := []struct {
+		raw     string
+		head    string
+		number  int
+		values  map[LabelKind][]string
+		unknown []string
+		text    string
+	}{
+		{
+			raw:    "1. tr. Masticar y deglutir un alimento sólido.",
+			number: 1,
+			values: map[LabelKind][]string{
+				LabelCategory:     {"verb"},
+				LabelVerbCategory: {"transitive"},
+			},
+			text: "Masticar y deglutir un alimento sólido.",
+		},
+		{
+			raw:    "4. intr. coloq. Méx. Comer deprisa.",
+			number: 4,
+			values: map[LabelKind][]string{
+				LabelVerbCategory: {"intransitive"},
+				LabelUsage:        {"colloquial"},
+				LabelRegion:       {"mexico"},
+			},
+			text: "Comer deprisa.",
+		},
+		{
+			raw:    "2. m. y f. Der. desus. Persona que litiga.",
+			number: 2,
+			values: map[LabelKind][]string{
+				LabelGender: {"masculine_and_feminine"},
+				LabelDomain: {"law"},
+				LabelUsage:  {"obsolete"},
+			},
+			text: "Persona que litiga.",
+		},
+		{
+			raw:    "3. f. C. Rica y P. Rico. Zool. Ave pequeña.",
+			number: 3,
+			values: map[LabelKind][]string{
+				LabelRegion: {"costa_rica", "puerto_rico"},
+				LabelDomain: {"zoology"},
+			},
+			text: "Ave pequeña.",
+		},
+		{
+			raw:     "5. tr. Náut. Amarrar un cabo.",
+			number:  5,
+			unknown: []string{"Náut."},
+			text:    "Amarrar un cabo.",
+		},
+		{
+			raw:    "a la buena de Dios. 1. loc. adv. coloq. Sin artificio.",
+			head:   "a la buena de Dios",
+			number: 1,
+			values: map[LabelKind][]string{
+				LabelCategory: {"adverb"},
+				LabelUsage:    {"colloquial"},
+			},
+			text: "Sin artificio.",
+		},
+		{
+			raw:    "1. interj. U. para animar.",
+			number: 1,
+			values: map[LabelKind][]string{
+				LabelCategory: {"interjection"},
+			},
+			text: "U. para animar.",
+		},
+		{
+			raw:    "2. f. Ant. Hamaca.",
+			number: 2,
+			values: map[LabelKind][]string{
+				LabelGender: {"feminine"},
+				LabelRegion: {"antilles"},
+			},
+			text: "Hamaca.",
+		},
+		{
+			raw:    "6. prnl. Hartarse.",
+			number: 6,
+			text:   "Hartarse.",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.raw, func(t *testing.T) {
+			got := ParseRaw(tt.raw)
+
+			if got.Head != tt.head {
+				t.Errorf("head: want %q, got %q", tt.head, got.Head)
+			}
+			if got.Number != tt.number {
+				t.Errorf("number: want %d, got %d", tt.number, got.Number)
+			}
+			for kind, want := range tt.values {
+				if values := got.Values(kind); !reflect.DeepEqual(values, want) {
+					t.Errorf("%s: want %v, got %v", kind, want, values)
+				}
+			}
+			if !reflect.DeepEqual(got.Unknown, tt.unknown) {
+				t.Errorf("unknown: want %v, got %v", tt.unknown, got.Unknown)
+			}
+			if got.Text != tt.text {
+				t.Errorf("text: want %q, got %q", tt.text, got.Text)
+			}
+		})
+	}
+}
+
+func TestDefinitionFillFromRaw(t *testing.T) {
+	d := Definition{
+		Raw:      "1. intr. coloq. Hablar mucho.",
+		Category: CategoryVerb,
+		Usage:    UsageCommon,
+	}
+
+	labels := d.FillFromRaw()
+
+	if d.VerbCategory == nil || *d.VerbCategory != VerbCategoryIntransitive {
+		t.Errorf("expected verb category to be recovered, got %v", d.VerbCategory)
+	}
+	if d.Usage != UsageCommon {
+		t.Errorf("expected usage from the API to be kept, got %s", d.Usage)
+	}
+
+	conflicts := labels.Conflicts(d)
+	if len(conflicts) != 1 || conflicts[0].Kind != LabelUsage {
+		t.Errorf("expected a single usage conflict, got %v", conflicts)
+	}
+}
@@ -0,0 +1,177 @@
+package rae
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Lemma is the structured form of a DLE headword such as "niño, ña" or
+// "banco²". Feminine is empty for words without a feminine form and
+// Homograph is zero for words without homographs.
+type Lemma struct {
+	Headword       string `json:"headword"`
+	Masculine      string `json:"masculine"`
+	FeminineEnding string `json:"feminine_ending,omitempty"`
+	Feminine       string `json:"feminine,omitempty"`
+	Homograph      int    `json:"homograph,omitempty"`
+}
+
+var superscripts = map[rune]int{
+	'⁰': 0, '¹': 1, '²': 2, '³': 3, '⁴': 4,
+	'⁵': 5, '⁶': 6, '⁷': 7, '⁸': 8, '⁹': 9,
+}
+
+var unaccented = map[rune]rune{'á': 'a', 'é': 'e', 'í': 'i', 'ó': 'o', 'ú': 'u'}
+
+// ParseHeadword parses a headword into its lemma. The feminine ending is
+// expanded following the DLE convention: it replaces the masculine form
+// from the last occurrence of its first letter, so "abad, desa" gives
+// "abadesa" and "actor, triz" gives "actriz".
+func ParseHeadword(headword string) Lemma {
+	lemma := Lemma{Headword: headword}
+
+	masculine, ending, _ := strings.Cut(headword, ",")
+	masculine, lemma.Homograph = splitHomograph(strings.TrimSpace(masculine))
+	lemma.Masculine = masculine
+
+	ending = strings.TrimSpace(ending)
+	if ending == "" {
+		return lemma
+	}
+	ending, homograph := splitHomograph(ending)
+	if lemma.Homograph == 0 {
+		lemma.Homograph = homograph
+	}
+
+	lemma.FeminineEnding = ending
+	lemma.Feminine = expandFeminine(masculine, ending)
+
+	return lemma
+}
+
+func splitHomograph(word string) (string, int) {
+	runes := []rune(word)
+	end := len(runes)
+	for end > 0 {
+		r := runes[end-1]
+		if _, ok := superscripts[r]; !ok && !unicode.IsDigit(r) {
+			break
+		}
+		end--
+	}
+	if end == len(runes) || end == 0 {
+		return word, 0
+	}
+
+	n := 0
+	for _, r := range runes[end:] {
+		if d, ok := superscripts[r]; ok {
+			n = n*10 + d
+		} else {
+			n = n*10 + int(r-'0')
+		}
+	}
+
+	return strings.TrimSpace(string(runes[:end])), n
+}
+
+func expandFeminine(masculine, ending string) string {
+	if masculine == "" || ending == "" {
+		return masculine
+	}
+
+	m := []rune(masculine)
+	e := []rune(ending)
+
+	stem := m
+	for i := len(m) - 1; i >= 0; i-- {
+		if foldAccent(m[i]) == foldAccent(e[0]) {
+			stem = m[:i]
+			break
+		}
+	}
+
+	feminine := append(append([]rune{}, stem...), e...)
+
+	// Words stressed on the last syllable and ending in -n or -s lose the
+	// written accent when the feminine adds a syllable: alemán, alemana.
+	last := m[len(m)-1]
+	if len(m) > 1 && len(stem) >= len(m)-1 && (last == 'n' || last == 's') {
+		if plain, ok := unaccented[m[len(m)-2]]; ok && len(feminine) > len(m) {
+			feminine[len(m)-2] = plain
+		}
+	}
+
+	return string(feminine)
+}
+
+// lemmaCandidates returns the headwords an inflected form may belong to:
+// the masculine of feminine forms, checked against the endings of the DLE
+// as in ParseHeadword, then the form itself and its singular. "buenas"
+// gives "bueno", "buenas" and "buena".
+func lemmaCandidates(word string) []string {
+	forms := []string{word}
+	if singular, ok := strings.CutSuffix(word, "s"); ok && utf8.RuneCountInString(singular) > 1 {
+		forms = append(forms, singular)
+	}
+
+	var masculines []string
+	for _, form := range forms {
+		stem, ok := strings.CutSuffix(form, "a")
+		if !ok || utf8.RuneCountInString(stem) < 2 {
+			continue
+		}
+		// "habladora" is the feminine of "hablador", "buena" of "bueno".
+		masculine := stem + "o"
+		if strings.HasSuffix(stem, "or") {
+			masculine = stem
+		}
+		runes := []rune(form)
+		if expandFeminine(masculine, string(runes[len(runes)-2:])) == form {
+			masculines = append(masculines, masculine)
+		}
+	}
+
+	return append(masculines, forms...)
+}
+
+func foldAccent(r rune) rune {
+	if plain, ok := unaccented[r]; ok {
+		return plain
+	}
+	return r
+}
+
+// Lemma parses the headword of the entry.
+func (e WordEntry) Lemma() Lemma {
+	return ParseHeadword(e.Word)
+}
+
+// Homograph returns the meaning belonging to the n-th homograph of the
+// headword, as in "banco¹" and "banco²".
+func (e WordEntry) Homograph(n int) (Meaning, bool) {
+	for _, m := range e.Meanings {
+		if m.Homograph == n {
+			return m, true
+		}
+	}
+	return Meaning{}, false
+}
+
+// fillHomographs numbers the meanings of the entry when the API did not.
+// Each meaning of an entry carries its own etymology, so several meanings
+// are several homographs.
+func (e *WordEntry) fillHomographs() {
+	if len(e.Meanings) == 1 {
+		if m := &e.Meanings[0]; m.Homograph == 0 {
+			m.Homograph = e.Lemma().Homograph
+		}
+		return
+	}
+	for i := range e.Meanings {
+		if e.Meanings[i].Homograph == 0 {
+			e.Meanings[i].Homograph = i + 1
+		}
+	}
+}
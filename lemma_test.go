@@ -0,0 +1,43 @@
+package rae
+
+import "testing"
+
+func TestParseHeadword(t *testing.T) {
+	tests := []struct {
+		headword string
+		want     Lemma
+	}{
+		{"niño, ña", Lemma{Masculine: "niño", FeminineEnding: "ña", Feminine: "niña"}},
+		{"abad, desa", Lemma{Masculine: "abad", FeminineEnding: "desa", Feminine: "abadesa"}},
+		{"actor, triz", Lemma{Masculine: "actor", FeminineEnding: "triz", Feminine: "actriz"}},
+		{"alemán, na", Lemma{Masculine: "alemán", FeminineEnding: "na", Feminine: "alemana"}},
+		{"francés, sa", Lemma{Masculine: "francés", FeminineEnding: "sa", Feminine: "francesa"}},
+		{"banco²", Lemma{Masculine: "banco", Homograph: 2}},
+		{"bueno, na¹", Lemma{Masculine: "bueno", FeminineEnding: "na", Feminine: "buena", Homograph: 1}},
+		{"casa", Lemma{Masculine: "casa"}},
+		{", na", Lemma{FeminineEnding: "na"}},
+	}
+
+	for _, tt := range tests {
+		tt.want.Headword = tt.headword
+		if got := ParseHeadword(tt.headword); got != tt.want {
+			t.Errorf("%q: want %+v, got %+v", tt.headword, tt.want, got)
+		}
+	}
+}
+
+func TestEnrichHomographs(t *testing.T) {
+	entry := WordEntry{
+		Word: "banco",
+		Meanings: []Meaning{
+			{Origin: &Origin{Raw: "Del germ. *banki."}},
+			{Origin: &Origin{Raw: "Del it. banco."}},
+		},
+	}
+	entry.Enrich()
+
+	m, ok := entry.Homograph(2)
+	if !ok || m.Origin.Type != OriginItalian {
+		t.Errorf("expected the second homograph to be the Italian one, got %+v", m)
+	}
+}
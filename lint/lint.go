@@ -0,0 +1,151 @@
+// Package lint checks WordEntry values for data-quality problems such as
+// duplicated synonyms, gaps in the sense numbering or conjugation tables
+// that do not agree with their infinitive.
+package lint
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	rae "github.com/rae-api-com/go-rae"
+)
+
+// Severity ranks findings. The zero value is Info.
+type Severity int
+
+const (
+	Info Severity = iota
+	Warning
+	Error
+)
+
+var severityNames = []string{"info", "warning", "error"}
+
+func (s Severity) String() string {
+	if s < 0 || int(s) >= len(severityNames) {
+		return fmt.Sprintf("Severity(%d)", int(s))
+	}
+	return severityNames[s]
+}
+
+// ParseSeverity parses the names returned by Severity.String.
+func ParseSeverity(name string) (Severity, error) {
+	for i, n := range severityNames {
+		if strings.EqualFold(n, name) {
+			return Severity(i), nil
+		}
+	}
+	return Info, fmt.Errorf("lint: unknown severity %q", name)
+}
+
+func (s Severity) MarshalText() ([]byte, error) {
+	return []byte(s.String()), nil
+}
+
+func (s *Severity) UnmarshalText(text []byte) error {
+	parsed, err := ParseSeverity(string(text))
+	if err != nil {
+		return err
+	}
+	*s = parsed
+	return nil
+}
+
+// Finding is a problem found by a rule. Path locates it in the entry using
+// the JSON names, e.g. "meanings[0].senses[2].synonyms[1]".
+type Finding struct {
+	Word     string   `json:"word"`
+	Rule     string   `json:"rule"`
+	Severity Severity `json:"severity"`
+	Path     string   `json:"path"`
+	Message  string   `json:"message"`
+}
+
+func (f Finding) String() string {
+	return fmt.Sprintf("%s: %s: %s: %s [%s]", f.Word, f.Path, f.Severity, f.Message, f.Rule)
+}
+
+// Reporter collects the findings of a rule.
+type Reporter struct {
+	rule     Rule
+	word     string
+	findings []Finding
+}
+
+// Report adds a finding with the severity of the rule.
+func (r *Reporter) Report(path, format string, args ...any) {
+	r.findings = append(r.findings, Finding{
+		Word:     r.word,
+		Rule:     r.rule.Name,
+		Severity: r.rule.Severity,
+		Path:     path,
+		Message:  fmt.Sprintf(format, args...),
+	})
+}
+
+// Rule is a named check over an entry.
+type Rule struct {
+	Name        string
+	Description string
+	Severity    Severity
+	Check       func(entry rae.WordEntry, r *Reporter)
+}
+
+var registry = map[string]Rule{}
+
+// Register adds a rule to the registry used by Lint. It panics if a rule
+// with the same name is already registered.
+func Register(rule Rule) {
+	if _, ok := registry[rule.Name]; ok {
+		panic("lint: rule registered twice: " + rule.Name)
+	}
+	registry[rule.Name] = rule
+}
+
+// Rules returns the registered rules sorted by name.
+func Rules() []Rule {
+	rules := make([]Rule, 0, len(registry))
+	for _, rule := range registry {
+		rules = append(rules, rule)
+	}
+	sort.Slice(rules, func(i, j int) bool { return rules[i].Name < rules[j].Name })
+	return rules
+}
+
+// Lookup returns the registered rule called name.
+func Lookup(name string) (Rule, bool) {
+	rule, ok := registry[name]
+	return rule, ok
+}
+
+// Lint runs every registered rule over entry.
+func Lint(entry rae.WordEntry) []Finding {
+	return Run(entry, Rules()...)
+}
+
+// Run runs rules over entry and returns their findings in rule order.
+func Run(entry rae.WordEntry, rules ...Rule) []Finding {
+	var findings []Finding
+	for _, rule := range rules {
+		r := &Reporter{rule: rule, word: entry.Word}
+		rule.Check(entry, r)
+		findings = append(findings, r.findings...)
+	}
+	return findings
+}
+
+// Max returns the highest severity of findings, and false when there are
+// none.
+func Max(findings []Finding) (Severity, bool) {
+	if len(findings) == 0 {
+		return Info, false
+	}
+	max := findings[0].Severity
+	for _, f := range findings[1:] {
+		if f.Severity > max {
+			max = f.Severity
+		}
+	}
+	return max, true
+}
@@ -0,0 +1,106 @@
+package lint
+
+import (
+	"testing"
+
+	rae "github.com/rae-api-com/go-rae"
+	"github.com/rae-api-com/go-rae/raetest"
+)
+
+func rulesOf(findings []Finding) map[string][]string {
+	rules := map[string][]string{}
+	for _, f := range findings {
+		rules[f.Rule] = append(rules[f.Rule], f.Path)
+	}
+	return rules
+}
+
+func TestFixturesAreClean(t *testing.T) {
+	for _, entry := range raetest.Entries() {
+		if findings := Lint(entry); len(findings) > 0 {
+			t.Errorf("%s: unexpected findings %v", entry.Word, findings)
+		}
+	}
+}
+
+func TestLint(t *testing.T) {
+	entry := raetest.Entries()[0] // hablar
+	m := &entry.Meanings[0]
+
+	m.Definitions[0].Synonyms = []string{"decir", "expresar", "Decir"}
+	m.Definitions[1].MeaningNumber = 1
+	m.Definitions = append(m.Definitions, rae.Definition{
+		MeaningNumber: 4,
+		Category:      rae.CategoryVerb,
+		Usage:         rae.UsageCommon,
+	})
+	m.Conjugations.ConjugationNonPersonal.CompoundGerund = "habiendo hablada"
+	m.Conjugations.ConjugationIndicative.PresentPerfect.SingularFirstPerson = "he hablado"
+
+	entry.Meanings = append(entry.Meanings, rae.Meaning{
+		Definitions: []rae.Definition{{
+			MeaningNumber: 1,
+			Category:      rae.CategoryVerb,
+			Description:   "Conversar.",
+		}},
+	})
+
+	got := rulesOf(Lint(entry))
+
+	want := map[string][]string{
+		"duplicate-synonyms":    {"meanings[0].senses[0].synonyms[2]"},
+		"meaning-number-repeat": {"meanings[0].senses[1].meaning_number"},
+		"meaning-number-gap":    {"meanings[0].senses[2].meaning_number"},
+		"empty-description":     {"meanings[0].senses[2].description"},
+		"verb-conjugations":     {"meanings[1].conjugations"},
+		"conjugation-infinitive": {
+			"meanings[0].conjugations.non_personal.compound_gerund",
+		},
+	}
+
+	for rule, paths := range want {
+		if len(got[rule]) != len(paths) {
+			t.Errorf("%s: expected %v, got %v", rule, paths, got[rule])
+			continue
+		}
+		for i := range paths {
+			if got[rule][i] != paths[i] {
+				t.Errorf("%s: expected %v, got %v", rule, paths, got[rule])
+			}
+		}
+	}
+	if len(got) != len(want) {
+		t.Errorf("unexpected rules: %v", got)
+	}
+}
+
+func TestInfinitiveMismatch(t *testing.T) {
+	entry := raetest.Entries()[0]
+	entry.Word = "hablarse"
+	entry.Meanings[0].Conjugations.ConjugationNonPersonal.Infinitive = "hablar"
+
+	rule, _ := Lookup("conjugation-infinitive")
+	if findings := Run(entry, rule); len(findings) != 0 {
+		t.Fatalf("pronominal headword should match: %v", findings)
+	}
+
+	entry.Word = "charlar"
+	findings := Run(entry, rule)
+	if len(findings) != 1 || findings[0].Severity != Error {
+		t.Fatalf("expected one error, got %v", findings)
+	}
+}
+
+func TestSeverity(t *testing.T) {
+	for _, s := range []Severity{Info, Warning, Error} {
+		got, err := ParseSeverity(s.String())
+		if err != nil || got != s {
+			t.Errorf("%s: got %v, %v", s, got, err)
+		}
+	}
+
+	max, ok := Max([]Finding{{Severity: Warning}, {Severity: Error}, {Severity: Info}})
+	if !ok || max != Error {
+		t.Fatalf("expected error, got %v", max)
+	}
+}
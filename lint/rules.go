@@ -0,0 +1,252 @@
+package lint
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	rae "github.com/rae-api-com/go-rae"
+)
+
+func init() {
+	Register(Rule{
+		Name:        "duplicate-synonyms",
+		Description: "a sense lists the same synonym or antonym twice",
+		Severity:    Warning,
+		Check:       checkDuplicateSynonyms,
+	})
+	Register(Rule{
+		Name:        "meaning-number-repeat",
+		Description: "two senses of a meaning share the same number",
+		Severity:    Error,
+		Check:       checkMeaningNumberRepeat,
+	})
+	Register(Rule{
+		Name:        "meaning-number-gap",
+		Description: "the senses of a meaning are not numbered 1, 2, 3...",
+		Severity:    Warning,
+		Check:       checkMeaningNumberGap,
+	})
+	Register(Rule{
+		Name:        "verb-conjugations",
+		Description: "a meaning with verb senses has no conjugations",
+		Severity:    Error,
+		Check:       checkVerbConjugations,
+	})
+	Register(Rule{
+		Name:        "empty-description",
+		Description: "a sense has an empty description",
+		Severity:    Error,
+		Check:       checkEmptyDescription,
+	})
+	Register(Rule{
+		Name:        "conjugation-infinitive",
+		Description: "the conjugation table does not agree with its infinitive",
+		Severity:    Error,
+		Check:       checkConjugationInfinitive,
+	})
+}
+
+func meaningPath(i int) string {
+	return fmt.Sprintf("meanings[%d]", i)
+}
+
+func sensePath(i, j int) string {
+	return fmt.Sprintf("meanings[%d].senses[%d]", i, j)
+}
+
+func checkDuplicateSynonyms(entry rae.WordEntry, r *Reporter) {
+	for i, m := range entry.Meanings {
+		for j, d := range m.Definitions {
+			for _, list := range []struct {
+				field string
+				words []string
+			}{
+				{"synonyms", d.Synonyms},
+				{"antonyms", d.Antonyms},
+			} {
+				field, words := list.field, list.words
+				seen := map[string]int{}
+				for k, w := range words {
+					key := strings.ToLower(strings.TrimSpace(w))
+					if first, ok := seen[key]; ok {
+						r.Report(
+							fmt.Sprintf("%s.%s[%d]", sensePath(i, j), field, k),
+							"%q duplicates %s[%d]", w, field, first,
+						)
+						continue
+					}
+					seen[key] = k
+				}
+			}
+		}
+	}
+}
+
+func checkMeaningNumberRepeat(entry rae.WordEntry, r *Reporter) {
+	for i, m := range entry.Meanings {
+		seen := map[int]int{}
+		for j, d := range m.Definitions {
+			if first, ok := seen[d.MeaningNumber]; ok {
+				r.Report(
+					sensePath(i, j)+".meaning_number",
+					"number %d is also used by senses[%d]", d.MeaningNumber, first,
+				)
+				continue
+			}
+			seen[d.MeaningNumber] = j
+		}
+	}
+}
+
+func checkMeaningNumberGap(entry rae.WordEntry, r *Reporter) {
+	for i, m := range entry.Meanings {
+		expected := 1
+		for j, d := range m.Definitions {
+			// repeats are reported by meaning-number-repeat
+			repeat := j > 0 && d.MeaningNumber == expected-1
+			if d.MeaningNumber != expected && !repeat {
+				r.Report(
+					sensePath(i, j)+".meaning_number",
+					"expected %d, got %d", expected, d.MeaningNumber,
+				)
+			}
+			expected = d.MeaningNumber + 1
+		}
+	}
+}
+
+func checkVerbConjugations(entry rae.WordEntry, r *Reporter) {
+	for i, m := range entry.Meanings {
+		if m.Conjugations != nil {
+			continue
+		}
+		for _, d := range m.Definitions {
+			if d.Category == rae.CategoryVerb {
+				r.Report(meaningPath(i)+".conjugations", "verb meaning without conjugations")
+				break
+			}
+		}
+	}
+}
+
+func checkEmptyDescription(entry rae.WordEntry, r *Reporter) {
+	for i, m := range entry.Meanings {
+		for j, d := range m.Definitions {
+			if strings.TrimSpace(d.Description) == "" {
+				r.Report(sensePath(i, j)+".description", "empty description")
+			}
+		}
+	}
+}
+
+var infinitiveEndings = []string{"ar", "er", "ir", "ír"}
+
+func checkConjugationInfinitive(entry rae.WordEntry, r *Reporter) {
+	headword := entry.Lemma().Masculine
+
+	for i, m := range entry.Meanings {
+		c := m.Conjugations
+		if c == nil {
+			continue
+		}
+
+		path := meaningPath(i) + ".conjugations"
+		np := c.ConjugationNonPersonal
+
+		if np.Infinitive == "" {
+			r.Report(path+".non_personal.infinitive", "empty infinitive")
+			continue
+		}
+
+		base := strings.TrimSuffix(np.Infinitive, "se")
+		if !hasAnySuffix(base, infinitiveEndings) {
+			r.Report(
+				path+".non_personal.infinitive",
+				"%q does not end in -ar, -er or -ir", np.Infinitive,
+			)
+		}
+
+		if headword != "" && headword != np.Infinitive &&
+			strings.TrimSuffix(headword, "se") != base {
+			r.Report(
+				path+".non_personal.infinitive",
+				"%q does not match the headword %q", np.Infinitive, headword,
+			)
+		}
+
+		if np.Participle == "" {
+			continue
+		}
+
+		compound := map[string]string{
+			"non_personal.compound_infinitive": np.CompoundInfinitive,
+			"non_personal.compound_gerund":     np.CompoundGerund,
+		}
+		for _, t := range compoundTenses(c) {
+			for person, form := range conjugationSlots(t.conjugation) {
+				compound[t.path+"."+person] = form
+			}
+		}
+
+		for _, slot := range sortedSlots(compound) {
+			form := compound[slot]
+			if form != "" && !strings.HasSuffix(form, " "+np.Participle) {
+				r.Report(
+					path+"."+slot,
+					"%q does not use the participle %q", form, np.Participle,
+				)
+			}
+		}
+	}
+}
+
+func hasAnySuffix(s string, suffixes []string) bool {
+	for _, suffix := range suffixes {
+		if strings.HasSuffix(s, suffix) {
+			return true
+		}
+	}
+	return false
+}
+
+type tense struct {
+	path        string
+	conjugation rae.Conjugation
+}
+
+func compoundTenses(c *rae.Conjugations) []tense {
+	ind, sub := c.ConjugationIndicative, c.ConjugationSubjunctive
+	return []tense{
+		{"indicative.present_perfect", ind.PresentPerfect},
+		{"indicative.past_perfect", ind.PastPerfect},
+		{"indicative.past_anterior", ind.PastAnterior},
+		{"indicative.future_perfect", ind.FuturePerfect},
+		{"indicative.conditional_perfect", ind.ConditionalPerfect},
+		{"subjunctive.present_perfect", sub.PresentPerfect},
+		{"subjunctive.past_perfect", sub.PastPerfect},
+		{"subjunctive.future_perfect", sub.FuturePerfect},
+	}
+}
+
+func conjugationSlots(c rae.Conjugation) map[string]string {
+	return map[string]string{
+		"singular_first_person":         c.SingularFirstPerson,
+		"singular_second_person":        c.SingularSecondPerson,
+		"singular_formal_second_person": c.SingularFormalSecondPerson,
+		"singular_third_person":         c.SingularThirdPerson,
+		"plural_first_person":           c.PluralFirstPerson,
+		"plural_second_person":          c.PluralSecondPerson,
+		"plural_formal_second_person":   c.PluralFormalSecondPerson,
+		"plural_third_person":           c.PluralThirdPerson,
+	}
+}
+
+func sortedSlots(slots map[string]string) []string {
+	keys := make([]string, 0, len(slots))
+	for k := range slots {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,128 @@
+package rae
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/rae-api-com/go-rae/collate"
+)
+
+// locutionStopWords are skipped when guessing which headword owns a
+// locution, since the DLE never files expressions under them.
+var locutionStopWords = map[string]bool{
+	"a": true, "al": true, "con": true, "de": true, "del": true, "el": true,
+	"en": true, "la": true, "las": true, "lo": true, "los": true, "o": true,
+	"para": true, "por": true, "que": true, "se": true, "sin": true,
+	"su": true, "un": true, "una": true, "y": true,
+}
+
+// Locutions returns every locution of the entry, including those nested in
+// additional senses.
+func (e WordEntry) Locutions() []Locution {
+	var locutions []Locution
+	for _, m := range e.Meanings {
+		locutions = append(locutions, m.Locutions...)
+		for _, s := range m.AdditionalSenses {
+			locutions = append(locutions, s.Locutions...)
+		}
+	}
+	return locutions
+}
+
+// Locution returns the locution matching phrase, ignoring case and spacing.
+func (e WordEntry) Locution(phrase string) (Locution, bool) {
+	phrase = normalizePhrase(phrase)
+	for _, l := range e.Locutions() {
+		if normalizePhrase(l.Text) == phrase {
+			return l, true
+		}
+	}
+	return Locution{}, false
+}
+
+// addLocution appends l unless a locution with the same text is already
+// present, which happens when the API sends locutions and their senses.
+func (m *Meaning) addLocution(l Locution) {
+	text := normalizePhrase(l.Text)
+	for i, existing := range m.Locutions {
+		if normalizePhrase(existing.Text) != text {
+			continue
+		}
+		if existing.Definition == "" {
+			m.Locutions[i].Definition = l.Definition
+		}
+		return
+	}
+	m.Locutions = append(m.Locutions, l)
+}
+
+// Locution finds the headword owning a multi-word expression such as
+// "a la buena de Dios". The search endpoint is tried first, then every
+// content word of the phrase, longest first.
+func (c *Client) Locution(ctx context.Context, phrase string) (WordEntry, Locution, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	results, _, err := getSearch(ctx, c.api, c.version, phrase)
+	if err == nil {
+		for _, r := range results {
+			entry, err := r.WordEntry()
+			if err != nil {
+				continue
+			}
+			if l, ok := entry.Locution(phrase); ok {
+				return *entry, l, nil
+			}
+		}
+	}
+
+	for _, word := range locutionHeadwords(phrase) {
+		entry, err := c.lookup(ctx, word)
+		if err != nil {
+			if ctx.Err() != nil {
+				return WordEntry{}, Locution{}, ctx.Err()
+			}
+			continue
+		}
+		if l, ok := entry.Locution(phrase); ok {
+			return entry, l, nil
+		}
+	}
+
+	return WordEntry{}, Locution{}, ErrLocutionNotFound
+}
+
+// locutionHeadwords returns the candidate headwords of a phrase, longest
+// word first. Inflected words are tried by their lemma before as written,
+// see lemmaCandidates.
+func locutionHeadwords(phrase string) []string {
+	var words []string
+	for _, w := range strings.Fields(normalizePhrase(phrase)) {
+		w = strings.Trim(w, ",;:¡!¿?")
+		if w == "" || locutionStopWords[w] {
+			continue
+		}
+		words = append(words, w)
+	}
+	sort.SliceStable(words, func(i, j int) bool {
+		return utf8.RuneCountInString(words[i]) > utf8.RuneCountInString(words[j])
+	})
+
+	var candidates []string
+	seen := map[string]bool{}
+	for _, w := range words {
+		for _, c := range lemmaCandidates(w) {
+			if !seen[c] {
+				seen[c] = true
+				candidates = append(candidates, c)
+			}
+		}
+	}
+	return candidates
+}
+
+func normalizePhrase(s string) string {
+	return collate.Fold(strings.TrimSuffix(strings.TrimSpace(s), "."))
+}
@@ -0,0 +1,60 @@
+package rae
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestEnrichLocutions(t *testing.T) {
+	entry := WordEntry{
+		Word: "bueno",
+		Meanings: []Meaning{{
+			Definitions: []Definition{
+				{Raw: "1. adj. Que tiene bondad.", Description: "Que tiene bondad."},
+				{
+					Raw:         "a la buena de Dios. 1. loc. adv. coloq. Sin artificio ni malicia.",
+					Description: "Sin artificio ni malicia.",
+				},
+			},
+			AdditionalSenses: []AdditionalSense{{
+				Number:    1,
+				Locutions: []Locution{{Text: "de buenas a primeras", Definition: "De repente."}},
+			}},
+		}},
+	}
+	entry.Enrich()
+
+	want := []Locution{
+		{Text: "a la buena de Dios", Definition: "Sin artificio ni malicia."},
+		{Text: "de buenas a primeras", Definition: "De repente."},
+	}
+	if got := entry.Locutions(); !reflect.DeepEqual(got, want) {
+		t.Fatalf("want %+v, got %+v", want, got)
+	}
+
+	if n := len(entry.Meanings[0].Definitions); n != 1 {
+		t.Errorf("expected the locution sense to leave the definitions, got %d definitions", n)
+	}
+
+	if _, ok := entry.Locution("A la  buena de Dios."); !ok {
+		t.Error("expected locution lookup to ignore case, spacing and final period")
+	}
+}
+
+func TestLocutionHeadwords(t *testing.T) {
+	tests := []struct {
+		phrase string
+		want   []string
+	}{
+		{phrase: "a la buena de Dios", want: []string{"bueno", "buena", "dios", "dio"}},
+		{phrase: "de buenas a primeras", want: []string{"primero", "primeras", "primera", "bueno", "buenas", "buena"}},
+		{phrase: "a la habladora", want: []string{"hablador", "habladora"}},
+		{phrase: "en casa", want: []string{"caso", "casa"}},
+	}
+
+	for _, tt := range tests {
+		if got := locutionHeadwords(tt.phrase); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%q: want %v, got %v", tt.phrase, tt.want, got)
+		}
+	}
+}
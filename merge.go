@@ -0,0 +1,243 @@
+package rae
+
+import (
+	"reflect"
+	"slices"
+)
+
+// MergeField names the parts of an entry whose precedence can be set
+// separately in a MergePolicy.
+type MergeField string
+
+const (
+	MergeSenses       MergeField = "senses"       // sense fields other than synonyms
+	MergeSynonyms     MergeField = "synonyms"     // synonyms and antonyms
+	MergeOrigin       MergeField = "origin"       // etymology
+	MergeConjugations MergeField = "conjugations" // conjugation tables, slot by slot
+	MergeLocutions    MergeField = "locutions"    // locutions and additional senses
+)
+
+// MergeSource is a partial entry and the name of where it comes from, such
+// as "api" or "conjugator".
+type MergeSource struct {
+	Name  string
+	Entry WordEntry
+}
+
+// MergePolicy sets which source wins when several provide the same value.
+// Order lists source names from highest to lowest precedence and Fields
+// overrides it for some parts of the entry. Sources not listed keep the
+// order they are given to Merge, after the listed ones.
+type MergePolicy struct {
+	Order  []string
+	Fields map[MergeField][]string
+}
+
+func (p MergePolicy) order(field MergeField, sources []MergeSource) []WordEntry {
+	names := p.Order
+	if fieldOrder, ok := p.Fields[field]; ok {
+		names = fieldOrder
+	}
+
+	rank := func(s MergeSource) int {
+		if i := slices.Index(names, s.Name); i >= 0 {
+			return i
+		}
+		return len(names)
+	}
+
+	sorted := slices.Clone(sources)
+	slices.SortStableFunc(sorted, func(a, b MergeSource) int {
+		return rank(a) - rank(b)
+	})
+
+	entries := make([]WordEntry, len(sorted))
+	for i, s := range sorted {
+		entries[i] = s.Entry
+	}
+	return entries
+}
+
+// Merge combines partial entries of the same word. Meanings are aligned by
+// position and senses by MeaningNumber. Every value is taken from the
+// source with the highest precedence that has it, so a lower source only
+// fills what the others leave empty. Synonyms, antonyms and locutions are
+// unions in precedence order. The merged entry shares no memory with the
+// sources.
+func Merge(policy MergePolicy, sources ...MergeSource) WordEntry {
+	var merged WordEntry
+
+	for _, e := range policy.order(MergeSenses, sources) {
+		if merged.Word == "" {
+			merged.Word = e.Word
+		}
+		merged.Suggestions = appendMissing(merged.Suggestions, e.Suggestions...)
+	}
+
+	n := 0
+	for _, s := range sources {
+		n = max(n, len(s.Entry.Meanings))
+	}
+
+	merged.Meanings = make([]Meaning, n)
+	for i := range merged.Meanings {
+		m := &merged.Meanings[i]
+
+		for _, e := range policy.order(MergeSenses, sources) {
+			if i >= len(e.Meanings) {
+				continue
+			}
+			if m.Homograph == 0 {
+				m.Homograph = e.Meanings[i].Homograph
+			}
+			m.Definitions = mergeSenses(m.Definitions, e.Meanings[i].Definitions)
+		}
+
+		for _, e := range policy.order(MergeSynonyms, sources) {
+			if i >= len(e.Meanings) {
+				continue
+			}
+			for _, d := range e.Meanings[i].Definitions {
+				j := slices.IndexFunc(m.Definitions, func(x Definition) bool {
+					return x.MeaningNumber == d.MeaningNumber
+				})
+				m.Definitions[j].Synonyms = appendMissing(m.Definitions[j].Synonyms, d.Synonyms...)
+				m.Definitions[j].Antonyms = appendMissing(m.Definitions[j].Antonyms, d.Antonyms...)
+			}
+		}
+
+		for _, e := range policy.order(MergeOrigin, sources) {
+			if i < len(e.Meanings) && m.Origin == nil && e.Meanings[i].Origin != nil {
+				origin := *e.Meanings[i].Origin
+				m.Origin = &origin
+			}
+		}
+
+		for _, e := range policy.order(MergeConjugations, sources) {
+			if i >= len(e.Meanings) || e.Meanings[i].Conjugations == nil {
+				continue
+			}
+			if m.Conjugations == nil {
+				m.Conjugations = &Conjugations{}
+			}
+			fillEmpty(reflect.ValueOf(m.Conjugations).Elem(), reflect.ValueOf(*e.Meanings[i].Conjugations))
+		}
+
+		for _, e := range policy.order(MergeLocutions, sources) {
+			if i >= len(e.Meanings) {
+				continue
+			}
+			for _, l := range e.Meanings[i].Locutions {
+				m.addLocution(l)
+			}
+			for _, s := range e.Meanings[i].AdditionalSenses {
+				if !slices.ContainsFunc(m.AdditionalSenses, func(x AdditionalSense) bool {
+					return x.Number == s.Number
+				}) {
+					s.Locutions = slices.Clone(s.Locutions)
+					m.AdditionalSenses = append(m.AdditionalSenses, s)
+				}
+			}
+		}
+	}
+
+	return merged
+}
+
+// mergeSenses fills the senses in dst with those of src, appending the
+// senses whose MeaningNumber is not in dst yet. Values are copied, so dst
+// shares no memory with src.
+func mergeSenses(dst, src []Definition) []Definition {
+	for _, d := range src {
+		d = d.clone()
+		j := slices.IndexFunc(dst, func(x Definition) bool {
+			return x.MeaningNumber == d.MeaningNumber
+		})
+		if j < 0 {
+			d.Synonyms, d.Antonyms = nil, nil
+			dst = append(dst, d)
+			continue
+		}
+
+		synonyms, antonyms := dst[j].Synonyms, dst[j].Antonyms
+		fillEmpty(reflect.ValueOf(&dst[j]).Elem(), reflect.ValueOf(d))
+		dst[j].Synonyms, dst[j].Antonyms = synonyms, antonyms
+	}
+
+	slices.SortStableFunc(dst, func(a, b Definition) int {
+		return a.MeaningNumber - b.MeaningNumber
+	})
+
+	return dst
+}
+
+// Clone returns a deep copy of the entry, which can be changed, for
+// instance by Enrich, without changing e. The entries of resolved
+// references are shared.
+func (e WordEntry) Clone() WordEntry {
+	e.Suggestions = slices.Clone(e.Suggestions)
+	e.Meanings = slices.Clone(e.Meanings)
+	for i := range e.Meanings {
+		m := &e.Meanings[i]
+		m.Origin = clonePointer(m.Origin)
+		m.Conjugations = clonePointer(m.Conjugations)
+		m.Definitions = slices.Clone(m.Definitions)
+		for j := range m.Definitions {
+			m.Definitions[j] = m.Definitions[j].clone()
+		}
+		m.Locutions = slices.Clone(m.Locutions)
+		m.AdditionalSenses = slices.Clone(m.AdditionalSenses)
+		for j := range m.AdditionalSenses {
+			m.AdditionalSenses[j].Locutions = slices.Clone(m.AdditionalSenses[j].Locutions)
+		}
+	}
+	return e
+}
+
+// clone returns a copy of d that shares no slices or pointers with it,
+// except the entries of its resolved references.
+func (d Definition) clone() Definition {
+	d.VerbCategory = clonePointer(d.VerbCategory)
+	d.Gender = clonePointer(d.Gender)
+	d.Article = clonePointer(d.Article)
+	d.Synonyms = slices.Clone(d.Synonyms)
+	d.Antonyms = slices.Clone(d.Antonyms)
+	d.Regions = slices.Clone(d.Regions)
+	d.Domains = slices.Clone(d.Domains)
+	d.References = slices.Clone(d.References)
+	return d
+}
+
+func clonePointer[T any](p *T) *T {
+	if p == nil {
+		return nil
+	}
+	v := *p
+	return &v
+}
+
+// fillEmpty copies into dst the fields of src that are empty in dst,
+// descending into nested structs.
+func fillEmpty(dst, src reflect.Value) {
+	if dst.Kind() == reflect.Struct {
+		for i := 0; i < dst.NumField(); i++ {
+			if dst.Type().Field(i).IsExported() {
+				fillEmpty(dst.Field(i), src.Field(i))
+			}
+		}
+		return
+	}
+
+	if dst.IsZero() || (dst.Kind() == reflect.Slice && dst.Len() == 0 && !src.IsNil()) {
+		dst.Set(src)
+	}
+}
+
+func appendMissing(dst []string, values ...string) []string {
+	for _, v := range values {
+		if !slices.Contains(dst, v) {
+			dst = append(dst, v)
+		}
+	}
+	return dst
+}
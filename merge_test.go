@@ -0,0 +1,98 @@
+package rae
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestMerge(t *testing.T) {
+	api := WordEntry{
+		Word: "hablar",
+		Meanings: []Meaning{{
+			Origin: &Origin{Raw: "Del lat. fabulāri."},
+			Definitions: []Definition{
+				{MeaningNumber: 1, Category: CategoryVerb, Description: "Articular palabras.", Synonyms: []string{"decir"}},
+				{MeaningNumber: 3, Description: "Conversar."},
+			},
+			Conjugations: &Conjugations{
+				ConjugationNonPersonal: ConjugationNonPersonal{Infinitive: "hablar", Gerund: "ablando"},
+			},
+		}},
+	}
+	local := WordEntry{
+		Word: "hablar",
+		Meanings: []Meaning{{
+			Definitions: []Definition{
+				{MeaningNumber: 1, Usage: UsageCommon, Description: "Decir.", Synonyms: []string{"expresar", "decir"}},
+				{MeaningNumber: 2, Description: "Emplear un idioma."},
+			},
+			Conjugations: &Conjugations{
+				ConjugationNonPersonal: ConjugationNonPersonal{Gerund: "hablando", Participle: "hablado"},
+			},
+		}},
+	}
+
+	got := Merge(
+		MergePolicy{
+			Order:  []string{"api", "conjugator"},
+			Fields: map[MergeField][]string{MergeConjugations: {"conjugator", "api"}},
+		},
+		MergeSource{Name: "conjugator", Entry: local},
+		MergeSource{Name: "api", Entry: api},
+	)
+
+	if got.Word != "hablar" || len(got.Meanings) != 1 {
+		t.Fatalf("unexpected entry: %+v", got)
+	}
+
+	m := got.Meanings[0]
+	if m.Origin == nil || m.Origin.Raw != "Del lat. fabulāri." {
+		t.Fatalf("origin not merged: %+v", m.Origin)
+	}
+
+	var numbers []int
+	for _, d := range m.Definitions {
+		numbers = append(numbers, d.MeaningNumber)
+	}
+	if !slices.Equal(numbers, []int{1, 2, 3}) {
+		t.Fatalf("expected senses 1, 2, 3, got %v", numbers)
+	}
+
+	first := m.Definitions[0]
+	if first.Description != "Articular palabras." || first.Usage != UsageCommon || first.Category != CategoryVerb {
+		t.Errorf("api should win and the conjugator fill the gaps: %+v", first)
+	}
+	if !slices.Equal(first.Synonyms, []string{"decir", "expresar"}) {
+		t.Errorf("unexpected synonyms %v", first.Synonyms)
+	}
+
+	np := m.Conjugations.ConjugationNonPersonal
+	if np.Gerund != "hablando" || np.Participle != "hablado" || np.Infinitive != "hablar" {
+		t.Errorf("conjugator should win for conjugations: %+v", np)
+	}
+}
+
+func TestMergeCopies(t *testing.T) {
+	gender := GenderMasculine
+	source := WordEntry{
+		Word: "casa",
+		Meanings: []Meaning{{
+			Definitions: []Definition{
+				{MeaningNumber: 1, Gender: &gender, Regions: []Region{RegionMexico}, Synonyms: []string{"hogar"}},
+			},
+			AdditionalSenses: []AdditionalSense{{Number: 1, Locutions: []Locution{{Text: "en casa"}}}},
+		}},
+	}
+
+	merged := Merge(MergePolicy{}, MergeSource{Name: "api", Entry: source})
+
+	d := &merged.Meanings[0].Definitions[0]
+	*d.Gender = GenderFeminine
+	d.Regions[0] = RegionSpain
+	merged.Meanings[0].AdditionalSenses[0].Locutions[0].Text = "a casa"
+
+	s := source.Meanings[0]
+	if gender != GenderMasculine || s.Definitions[0].Regions[0] != RegionMexico || s.AdditionalSenses[0].Locutions[0].Text != "en casa" {
+		t.Errorf("the merged entry shares memory with its source: %+v", s)
+	}
+}
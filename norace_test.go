@@ -0,0 +1,5 @@
+//go:build !race
+
+package rae_test
+
+const raceEnabled = false
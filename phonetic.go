@@ -0,0 +1,73 @@
+package rae
+
+import (
+	"context"
+	"sort"
+
+	"github.com/rae-api-com/go-rae/phonetic"
+)
+
+// minPhoneticScore is the score a search hit needs to be returned by
+// SearchPhonetic when no phonetic index is set.
+const minPhoneticScore = 0.75
+
+// ScoredResult is a search hit along with how closely it matches the
+// query, from 0 to 1.
+type ScoredResult struct {
+	Doc   Document `json:"doc"`
+	Hits  int      `json:"hits"`
+	Score float64  `json:"score"`
+}
+
+// WordEntry decodes the entry of the hit. Hits found in a local phonetic
+// index carry no entry; look them up with Client.Word instead.
+func (r *ScoredResult) WordEntry() (*WordEntry, error) {
+	return decodeEntry(r.Doc.Raw)
+}
+
+// SearchPhonetic finds the headwords that sound like word. See
+// phonetic.Encode for the spellings taken as equal. Results are sorted by
+// Score.
+//
+// With WithPhoneticIndex the headwords of the index are matched locally and
+// the API is not called, so that "kaza" finds "casa" and "caza" and "yubia"
+// finds "lluvia". Otherwise the hits of Search, which matches substrings,
+// are ranked by how they sound and those too far from word are dropped: a
+// misspelling such as "kaza" finds nothing, fuzzy matching needs an index.
+func (c *Client) SearchPhonetic(ctx context.Context, word string) ([]ScoredResult, error) {
+	word, err := normalizeSearch(word)
+	if err != nil {
+		return nil, err
+	}
+
+	if c.phonetic != nil {
+		var results []ScoredResult
+		for _, m := range c.phonetic.Lookup(word) {
+			results = append(results, ScoredResult{
+				Doc:   Document{Word: m.Word},
+				Score: m.Score,
+			})
+		}
+		return results, nil
+	}
+
+	hits, err := c.Search(ctx, word)
+	if err != nil {
+		return nil, err
+	}
+
+	var results []ScoredResult
+	for _, h := range hits {
+		score := phonetic.Similarity(word, h.Doc.Word)
+		if score < minPhoneticScore {
+			continue
+		}
+		results = append(results, ScoredResult{Doc: h.Doc, Hits: h.Hits, Score: score})
+	}
+
+	sort.SliceStable(results, func(i, j int) bool {
+		return results[i].Score > results[j].Score
+	})
+
+	return results, nil
+}
@@ -0,0 +1,178 @@
+// Package phonetic encodes Spanish words by how they sound, so that input
+// transcribed from speech or typed by learners, such as "kaza" or "yubia",
+// matches the headwords "casa" and "lluvia".
+package phonetic
+
+import (
+	"strings"
+
+	"github.com/rae-api-com/go-rae/collate"
+)
+
+// Encode returns the phonetic key of word. Spellings that sound the same in
+// most of the Spanish speaking world share a key:
+//
+//   - b and v (BACA for "vaca" and "baca")
+//   - c before e or i, s, z and, at the start of a word, x (seseo, ceceo)
+//   - ll and y before a vowel (yeísmo)
+//   - h, which is silent except in ch
+//   - c before a, o, u or a consonant, k and qu
+//   - g before e or i and j; gu before e or i and g elsewhere
+//   - r and rr, and doubled letters in general
+//
+// Accents and case are ignored. Keys are made of the uppercase letters of
+// the sounds, e.g. Encode("guitarra") is "GITARA".
+func Encode(word string) string {
+	letters := []rune(letterRun(word))
+
+	var (
+		b    strings.Builder
+		last string
+	)
+	emit := func(code string) {
+		// doubled sounds are one, as in "acción" or "perro"
+		if code == last {
+			return
+		}
+		b.WriteString(code)
+		last = code
+	}
+
+	at := func(i int) rune {
+		if i < 0 || i >= len(letters) {
+			return 0
+		}
+		return letters[i]
+	}
+
+	for i := 0; i < len(letters); i++ {
+		r, next := letters[i], at(i+1)
+
+		switch r {
+		case 'a', 'e', 'i', 'o', 'u':
+			emit(strings.ToUpper(string(r)))
+		case 'ü', 'w':
+			emit("U")
+		case 'b', 'v':
+			emit("B")
+		case 'c':
+			switch {
+			case next == 'h':
+				emit("X")
+				i++
+			case next == 'e' || next == 'i':
+				emit("S")
+			default:
+				emit("K")
+			}
+		case 'g':
+			switch {
+			case next == 'e' || next == 'i':
+				emit("J")
+			case next == 'u' && (at(i+2) == 'e' || at(i+2) == 'i'):
+				emit("G")
+				i++
+			default:
+				emit("G")
+			}
+		case 'h':
+		case 'j':
+			emit("J")
+		case 'k':
+			emit("K")
+		case 'l':
+			if next == 'l' {
+				emit("Y")
+				i++
+			} else {
+				emit("L")
+			}
+		case 'q':
+			emit("K")
+			if next == 'u' {
+				i++
+			}
+		case 's', 'z':
+			emit("S")
+		case 'x':
+			if i == 0 {
+				emit("S")
+			} else {
+				emit("K")
+				emit("S")
+			}
+		case 'y':
+			if isVowel(next) {
+				emit("Y")
+			} else {
+				emit("I")
+			}
+		case 'ñ':
+			emit("Ñ")
+		default:
+			emit(strings.ToUpper(string(r)))
+		}
+	}
+
+	return b.String()
+}
+
+// letterRun folds word and keeps its letters, without accents but with the
+// diaeresis, which makes the u of "güe" sound.
+func letterRun(word string) string {
+	var b strings.Builder
+	for _, r := range collate.Fold(word) {
+		switch {
+		case r == 'ü':
+			b.WriteRune(r)
+		case r >= 'a' && r <= 'z', r == 'ñ':
+			b.WriteRune(r)
+		default:
+			if base := []rune(collate.StripAccents(string(r))); len(base) == 1 && base[0] >= 'a' && base[0] <= 'z' {
+				b.WriteRune(base[0])
+			}
+		}
+	}
+	return b.String()
+}
+
+func isVowel(r rune) bool {
+	return strings.ContainsRune("aeiouü", r)
+}
+
+// Similarity compares the keys of a and b and returns 1 for words that
+// sound the same, decreasing towards 0 with every differing sound.
+func Similarity(a, b string) float64 {
+	return keySimilarity(Encode(a), Encode(b))
+}
+
+func keySimilarity(a, b string) float64 {
+	ra, rb := []rune(a), []rune(b)
+	n := max(len(ra), len(rb))
+	if n == 0 {
+		return 0
+	}
+	return 1 - float64(levenshtein(ra, rb))/float64(n)
+}
+
+func levenshtein(a, b []rune) int {
+	prev := make([]int, len(b)+1)
+	cur := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		cur[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			cur[j] = min(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+		}
+		prev, cur = cur, prev
+	}
+
+	return prev[len(b)]
+}
@@ -0,0 +1,124 @@
+package phonetic
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/rae-api-com/go-rae/collate"
+)
+
+// Match is a headword that sounds like the input. Score is 1 for the same
+// phonetic key and decreases with every differing sound.
+type Match struct {
+	Word  string  `json:"word"`
+	Key   string  `json:"key"`
+	Score float64 `json:"score"`
+}
+
+// Index groups headwords by phonetic key. It is safe for concurrent use.
+type Index struct {
+	// MinScore and Limit are used by Lookup. They default to 0.75 and 10
+	// when zero.
+	MinScore float64
+	Limit    int
+
+	mu    sync.RWMutex
+	byKey map[string][]string
+	size  int
+}
+
+// NewIndex returns an index over words.
+func NewIndex(words ...string) *Index {
+	idx := &Index{byKey: map[string][]string{}}
+	idx.Add(words...)
+	return idx
+}
+
+func (idx *Index) Add(words ...string) {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+
+	if idx.byKey == nil {
+		idx.byKey = map[string][]string{}
+	}
+
+	for _, w := range words {
+		w = collate.Normalize(w)
+		key := Encode(w)
+		if key == "" || contains(idx.byKey[key], w) {
+			continue
+		}
+		idx.byKey[key] = append(idx.byKey[key], w)
+		idx.size++
+	}
+}
+
+func contains(words []string, w string) bool {
+	for _, x := range words {
+		if x == w {
+			return true
+		}
+	}
+	return false
+}
+
+// Len returns the number of distinct words in the index.
+func (idx *Index) Len() int {
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+	return idx.size
+}
+
+// Matches returns up to limit words scoring at least minScore against word,
+// best first. Among words with the same score, those spelt like word, but
+// for accents and case, come first and the rest in alphabetical order. A
+// limit of zero or less returns every match.
+func (idx *Index) Matches(word string, minScore float64, limit int) []Match {
+	key := Encode(word)
+	if key == "" {
+		return nil
+	}
+	folded := collate.Key(word)
+
+	idx.mu.RLock()
+	var found []Match
+	for k, words := range idx.byKey {
+		score := keySimilarity(key, k)
+		if score < minScore {
+			continue
+		}
+		for _, w := range words {
+			found = append(found, Match{Word: w, Key: k, Score: score})
+		}
+	}
+	idx.mu.RUnlock()
+
+	sort.Slice(found, func(i, j int) bool {
+		a, b := found[i], found[j]
+		if a.Score != b.Score {
+			return a.Score > b.Score
+		}
+		if sa, sb := collate.Key(a.Word) == folded, collate.Key(b.Word) == folded; sa != sb {
+			return sa
+		}
+		return collate.Compare(a.Word, b.Word) < 0
+	})
+
+	if limit > 0 && len(found) > limit {
+		found = found[:limit]
+	}
+
+	return found
+}
+
+// Lookup returns the words that sound like word using MinScore and Limit.
+func (idx *Index) Lookup(word string) []Match {
+	minScore, limit := idx.MinScore, idx.Limit
+	if minScore <= 0 {
+		minScore = 0.75
+	}
+	if limit <= 0 {
+		limit = 10
+	}
+	return idx.Matches(word, minScore, limit)
+}
@@ -0,0 +1,100 @@
+package phonetic
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestEncode(t *testing.T) {
+	tests := []struct {
+		word, want string
+	}{
+		{"casa", "KASA"},
+		{"Casa", "KASA"},
+		{"vaca", "BAKA"},
+		{"cielo", "SIELO"},
+		{"zapato", "SAPATO"},
+		{"hablar", "ABLAR"},
+		{"chico", "XIKO"},
+		{"lluvia", "YUBIA"},
+		{"rey", "REI"},
+		{"queso", "KESO"},
+		{"kilo", "KILO"},
+		{"guitarra", "GITARA"},
+		{"gato", "GATO"},
+		{"gente", "JENTE"},
+		{"pingüino", "PINGUINO"},
+		{"acción", "AKSION"},
+		{"examen", "EKSAMEN"},
+		{"xilófono", "SILOFONO"},
+		{"niño", "NIÑO"},
+		{"", ""},
+	}
+
+	for _, tt := range tests {
+		if got := Encode(tt.word); got != tt.want {
+			t.Errorf("Encode(%q) = %q, want %q", tt.word, got, tt.want)
+		}
+	}
+}
+
+func TestHomophones(t *testing.T) {
+	pairs := [][2]string{
+		{"kaza", "casa"},
+		{"cazar", "casar"},
+		{"ola", "hola"},
+		{"yubia", "lluvia"},
+		{"baca", "vaca"},
+		{"keso", "queso"},
+		{"girafa", "jirafa"},
+		{"jente", "gente"},
+		{"sielo", "cielo"},
+	}
+
+	for _, p := range pairs {
+		if Encode(p[0]) != Encode(p[1]) {
+			t.Errorf("%q (%s) and %q (%s) should sound the same", p[0], Encode(p[0]), p[1], Encode(p[1]))
+		}
+	}
+
+	if Encode("niño") == Encode("nino") {
+		t.Error("ñ should not sound like n")
+	}
+	if Similarity("gato", "pato") >= 1 {
+		t.Error("gato and pato should not sound the same")
+	}
+	if Similarity("casa", "") != 0 {
+		t.Error("similarity to an empty word should be zero")
+	}
+}
+
+func TestIndexLookup(t *testing.T) {
+	idx := NewIndex("casa", "caza", "cosa", "lluvia", "huevo", "perro", "casa")
+	if idx.Len() != 6 {
+		t.Fatalf("Len = %d, want 6", idx.Len())
+	}
+
+	words := func(matches []Match) []string {
+		var out []string
+		for _, m := range matches {
+			out = append(out, m.Word)
+		}
+		return out
+	}
+
+	if got := words(idx.Lookup("kaza")); !slices.Equal(got, []string{"casa", "caza", "cosa"}) {
+		t.Errorf("kaza: got %v", got)
+	}
+	if got := words(idx.Lookup("caza")); !slices.Equal(got, []string{"caza", "casa", "cosa"}) {
+		t.Errorf("caza: same spelling should come first, got %v", got)
+	}
+	if got := idx.Lookup("yubia"); len(got) != 1 || got[0].Word != "lluvia" || got[0].Score != 1 {
+		t.Errorf("yubia: got %v", got)
+	}
+	if got := idx.Lookup("guebo"); len(got) != 1 || got[0].Word != "huevo" || got[0].Score != 0.75 {
+		t.Errorf("guebo: got %v", got)
+	}
+	if got := idx.Lookup("xyz"); len(got) != 0 {
+		t.Errorf("xyz: got %v", got)
+	}
+}
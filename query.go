@@ -0,0 +1,321 @@
+package rae
+
+import (
+	"fmt"
+	"iter"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// Sense is a definition along with the meaning it belongs to.
+type Sense struct {
+	Meaning    Meaning
+	Definition Definition
+}
+
+// SenseFilter selects senses of an entry. Filters are immutable: every
+// method returns a new filter, so a partial filter can be shared and
+// refined.
+type SenseFilter struct {
+	entry WordEntry
+	preds []func(Sense) bool
+}
+
+// Senses returns a filter over every sense of the entry, e.g.
+//
+//	entry.Senses().Category(CategoryVerb).ExcludeUsage(UsageObsolete)
+func (e WordEntry) Senses() SenseFilter {
+	return SenseFilter{entry: e}
+}
+
+// Where keeps the senses matching pred.
+func (f SenseFilter) Where(pred func(Sense) bool) SenseFilter {
+	return SenseFilter{
+		entry: f.entry,
+		preds: append(slices.Clip(f.preds), pred),
+	}
+}
+
+func (f SenseFilter) Category(categories ...WordCategory) SenseFilter {
+	return f.Where(func(s Sense) bool {
+		return slices.Contains(categories, s.Definition.Category)
+	})
+}
+
+func (f SenseFilter) ExcludeCategory(categories ...WordCategory) SenseFilter {
+	return f.Where(func(s Sense) bool {
+		return !slices.Contains(categories, s.Definition.Category)
+	})
+}
+
+func (f SenseFilter) VerbCategory(categories ...VerbCategory) SenseFilter {
+	return f.Where(hasVerbCategory(categories))
+}
+
+func hasVerbCategory(categories []VerbCategory) func(Sense) bool {
+	return func(s Sense) bool {
+		vc := s.Definition.VerbCategory
+		return vc != nil && slices.Contains(categories, *vc)
+	}
+}
+
+func (f SenseFilter) Usage(usages ...Usage) SenseFilter {
+	return f.Where(func(s Sense) bool {
+		return slices.Contains(usages, s.Definition.Usage)
+	})
+}
+
+func (f SenseFilter) ExcludeUsage(usages ...Usage) SenseFilter {
+	return f.Where(func(s Sense) bool {
+		return !slices.Contains(usages, s.Definition.Usage)
+	})
+}
+
+// Gender keeps the senses of the given genders. Senses marked "m. y f."
+// match both GenderMasculine and GenderFeminine.
+func (f SenseFilter) Gender(genders ...Gender) SenseFilter {
+	return f.Where(func(s Sense) bool {
+		return hasGender(s.Definition, genders)
+	})
+}
+
+func (f SenseFilter) ExcludeGender(genders ...Gender) SenseFilter {
+	return f.Where(func(s Sense) bool {
+		return !hasGender(s.Definition, genders)
+	})
+}
+
+func hasGender(d Definition, genders []Gender) bool {
+	if d.Gender == nil {
+		return false
+	}
+	for _, g := range genders {
+		if *d.Gender == g ||
+			(*d.Gender == GenderBoth && (g == GenderMasculine || g == GenderFeminine)) {
+			return true
+		}
+	}
+	return false
+}
+
+// Region keeps the senses used in any of the countries with the given ISO
+// codes, see Region.Covers.
+func (f SenseFilter) Region(isos ...string) SenseFilter {
+	return f.Where(hasRegion(isos))
+}
+
+func hasRegion(isos []string) func(Sense) bool {
+	return func(s Sense) bool {
+		return slices.ContainsFunc(s.Definition.Regions, func(r Region) bool {
+			return slices.ContainsFunc(isos, r.Covers)
+		})
+	}
+}
+
+func (f SenseFilter) Domain(domains ...Domain) SenseFilter {
+	return f.Where(hasDomain(domains))
+}
+
+func hasDomain(domains []Domain) func(Sense) bool {
+	return func(s Sense) bool {
+		return slices.ContainsFunc(s.Definition.Domains, func(d Domain) bool {
+			return slices.Contains(domains, d)
+		})
+	}
+}
+
+// Homograph keeps the senses of the n-th homograph.
+func (f SenseFilter) Homograph(n int) SenseFilter {
+	return f.Where(func(s Sense) bool {
+		return s.Meaning.Homograph == n
+	})
+}
+
+// All iterates over the matching senses in entry order.
+func (f SenseFilter) All() iter.Seq[Sense] {
+	return func(yield func(Sense) bool) {
+		for _, m := range f.entry.Meanings {
+		senses:
+			for _, d := range m.Definitions {
+				s := Sense{Meaning: m, Definition: d}
+				for _, pred := range f.preds {
+					if !pred(s) {
+						continue senses
+					}
+				}
+				if !yield(s) {
+					return
+				}
+			}
+		}
+	}
+}
+
+func (f SenseFilter) Slice() []Sense {
+	return slices.Collect(f.All())
+}
+
+func (f SenseFilter) Count() int {
+	n := 0
+	for range f.All() {
+		n++
+	}
+	return n
+}
+
+func (f SenseFilter) First() (Sense, bool) {
+	for s := range f.All() {
+		return s, true
+	}
+	return Sense{}, false
+}
+
+// Query is a parsed textual filter, see ParseQuery.
+type Query struct {
+	text  string
+	apply []func(SenseFilter) SenseFilter
+}
+
+func (q Query) String() string {
+	return q.text
+}
+
+// Apply refines f with the terms of the query.
+func (q Query) Apply(f SenseFilter) SenseFilter {
+	for _, apply := range q.apply {
+		f = apply(f)
+	}
+	return f
+}
+
+// ParseQuery parses space separated "key:value" terms. Values may be
+// separated by commas to match any of them and prefixed by "!" to exclude
+// them, e.g. "category:verb,noun usage:!obsolete gender:feminine". The
+// keys are category, verb_category, usage, gender, region, domain and
+// homograph.
+func ParseQuery(text string) (Query, error) {
+	q := Query{text: text}
+
+	for _, term := range strings.Fields(text) {
+		key, value, ok := strings.Cut(term, ":")
+		if !ok || value == "" {
+			return Query{}, fmt.Errorf("query: %q is not key:value", term)
+		}
+
+		negate := strings.HasPrefix(value, "!")
+		values := strings.Split(strings.TrimPrefix(value, "!"), ",")
+
+		apply, err := queryTerm(strings.ToLower(key), values, negate)
+		if err != nil {
+			return Query{}, fmt.Errorf("query: %s: %w", term, err)
+		}
+		q.apply = append(q.apply, apply)
+	}
+
+	return q, nil
+}
+
+func queryTerm(key string, values []string, negate bool) (func(SenseFilter) SenseFilter, error) {
+	switch key {
+	case "category":
+		categories, err := parseEnumValues[WordCategory](values)
+		if err != nil {
+			return nil, err
+		}
+		return func(f SenseFilter) SenseFilter {
+			if negate {
+				return f.ExcludeCategory(categories...)
+			}
+			return f.Category(categories...)
+		}, nil
+
+	case "verb_category", "verb":
+		categories, err := parseEnumValues[VerbCategory](values)
+		if err != nil {
+			return nil, err
+		}
+		return func(f SenseFilter) SenseFilter {
+			return f.Where(negated(hasVerbCategory(categories), negate))
+		}, nil
+
+	case "usage":
+		usages, err := parseEnumValues[Usage](values)
+		if err != nil {
+			return nil, err
+		}
+		return func(f SenseFilter) SenseFilter {
+			if negate {
+				return f.ExcludeUsage(usages...)
+			}
+			return f.Usage(usages...)
+		}, nil
+
+	case "gender":
+		genders, err := parseEnumValues[Gender](values)
+		if err != nil {
+			return nil, err
+		}
+		return func(f SenseFilter) SenseFilter {
+			if negate {
+				return f.ExcludeGender(genders...)
+			}
+			return f.Gender(genders...)
+		}, nil
+
+	case "region":
+		return func(f SenseFilter) SenseFilter {
+			return f.Where(negated(hasRegion(values), negate))
+		}, nil
+
+	case "domain":
+		domains := make([]Domain, len(values))
+		for i, v := range values {
+			domains[i] = Domain(v)
+		}
+		return func(f SenseFilter) SenseFilter {
+			return f.Where(negated(hasDomain(domains), negate))
+		}, nil
+
+	case "homograph":
+		numbers := make([]int, len(values))
+		for i, v := range values {
+			n, err := strconv.Atoi(v)
+			if err != nil {
+				return nil, fmt.Errorf("invalid homograph %q", v)
+			}
+			numbers[i] = n
+		}
+		return func(f SenseFilter) SenseFilter {
+			return f.Where(negated(func(s Sense) bool {
+				return slices.Contains(numbers, s.Meaning.Homograph)
+			}, negate))
+		}, nil
+	}
+
+	return nil, fmt.Errorf("unknown key %q", key)
+}
+
+func negated(pred func(Sense) bool, negate bool) func(Sense) bool {
+	if !negate {
+		return pred
+	}
+	return func(s Sense) bool { return !pred(s) }
+}
+
+type enumValue[T any] interface {
+	~string
+	IsValid() bool
+	Values() []T
+}
+
+func parseEnumValues[T enumValue[T]](values []string) ([]T, error) {
+	out := make([]T, len(values))
+	for i, v := range values {
+		out[i] = T(strings.ToLower(v))
+		if !out[i].IsValid() {
+			return nil, fmt.Errorf("invalid value %q, expected one of %v", v, out[i].Values())
+		}
+	}
+	return out, nil
+}
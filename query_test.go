@@ -0,0 +1,119 @@
+package rae
+
+import (
+	"testing"
+)
+
+func queryFixture() WordEntry {
+	transitive := VerbCategoryTransitive
+	feminine := GenderFeminine
+	both := GenderBoth
+	masculine := GenderMasculine
+
+	return WordEntry{
+		Word: "cura",
+		Meanings: []Meaning{
+			{
+				Homograph: 1,
+				Definitions: []Definition{
+					{MeaningNumber: 1, Category: CategoryNoun, Gender: &masculine, Usage: UsageCommon, Description: "Sacerdote."},
+					{MeaningNumber: 2, Category: CategoryNoun, Gender: &feminine, Usage: UsageCommon, Description: "Curación.", Domains: []Domain{DomainMedicine}},
+					{MeaningNumber: 3, Category: CategoryNoun, Gender: &both, Usage: UsageObsolete, Description: "Persona que cura.", Regions: []Region{RegionMexico}},
+				},
+			},
+			{
+				Homograph: 2,
+				Definitions: []Definition{
+					{MeaningNumber: 1, Category: CategoryVerb, VerbCategory: &transitive, Usage: UsageObsolete, Description: "Curar."},
+					{MeaningNumber: 2, Category: CategoryVerb, Usage: UsageCommon, Description: "Sanar."},
+				},
+			},
+		},
+	}
+}
+
+func descriptions(f SenseFilter) []string {
+	var out []string
+	for s := range f.All() {
+		out = append(out, s.Definition.Description)
+	}
+	return out
+}
+
+func TestSenseFilter(t *testing.T) {
+	entry := queryFixture()
+
+	tests := []struct {
+		name   string
+		filter SenseFilter
+		want   []string
+	}{
+		{"all", entry.Senses(), []string{"Sacerdote.", "Curación.", "Persona que cura.", "Curar.", "Sanar."}},
+		{"verbs not obsolete", entry.Senses().Category(CategoryVerb).ExcludeUsage(UsageObsolete), []string{"Sanar."}},
+		{"feminine nouns", entry.Senses().Category(CategoryNoun).Gender(GenderFeminine), []string{"Curación.", "Persona que cura."}},
+		{"transitive", entry.Senses().VerbCategory(VerbCategoryTransitive), []string{"Curar."}},
+		{"region", entry.Senses().Region("MX"), []string{"Persona que cura."}},
+		{"domain", entry.Senses().Domain(DomainMedicine), []string{"Curación."}},
+		{"homograph", entry.Senses().Homograph(2).Usage(UsageCommon), []string{"Sanar."}},
+	}
+
+	for _, tt := range tests {
+		got := descriptions(tt.filter)
+		if len(got) != len(tt.want) {
+			t.Errorf("%s: expected %v, got %v", tt.name, tt.want, got)
+			continue
+		}
+		for i := range got {
+			if got[i] != tt.want[i] {
+				t.Errorf("%s: expected %v, got %v", tt.name, tt.want, got)
+				break
+			}
+		}
+	}
+
+	s, ok := entry.Senses().Category(CategoryVerb).First()
+	if !ok || s.Meaning.Homograph != 2 {
+		t.Fatalf("expected the sense to carry its meaning, got %+v", s)
+	}
+
+	nouns := entry.Senses().Category(CategoryNoun)
+	_ = nouns.Usage(UsageObsolete)
+	if nouns.Count() != 3 {
+		t.Fatal("refining a filter must not modify it")
+	}
+}
+
+func TestParseQuery(t *testing.T) {
+	entry := queryFixture()
+
+	tests := []struct {
+		query string
+		want  int
+	}{
+		{"category:verb usage:!obsolete", 1},
+		{"category:noun gender:feminine", 2},
+		{"category:noun,verb", 5},
+		{"gender:!masculine", 3},
+		{"region:mx", 1},
+		{"domain:medicine", 1},
+		{"homograph:2 verb_category:transitive", 1},
+		{"", 5},
+	}
+
+	for _, tt := range tests {
+		q, err := ParseQuery(tt.query)
+		if err != nil {
+			t.Errorf("%q: %v", tt.query, err)
+			continue
+		}
+		if got := q.Apply(entry.Senses()).Count(); got != tt.want {
+			t.Errorf("%q: expected %d senses, got %d", tt.query, tt.want, got)
+		}
+	}
+
+	for _, bad := range []string{"category", "category:verbo", "color:red", "homograph:x"} {
+		if _, err := ParseQuery(bad); err == nil {
+			t.Errorf("%q: expected an error", bad)
+		}
+	}
+}
@@ -0,0 +1,129 @@
+package raetest
+
+import rae "github.com/rae-api-com/go-rae"
+
+// Entries returns the entries served by default: a regular verb, a noun
+// and a word with a regional sense.
+func Entries() []rae.WordEntry {
+	transitive := rae.VerbCategoryTransitive
+	intransitive := rae.VerbCategoryIntransitive
+	feminine := rae.GenderFeminine
+	masculine := rae.GenderMasculine
+
+	return []rae.WordEntry{
+		{
+			Word: "hablar",
+			Meanings: []rae.Meaning{{
+				Origin: &rae.Origin{
+					Raw:  "Del lat. fabulāri.",
+					Type: rae.OriginLatin,
+					Text: "fabulāri",
+				},
+				Definitions: []rae.Definition{
+					{
+						Raw:           "1. intr. Articular, proferir palabras para darse a entender.",
+						MeaningNumber: 1,
+						Category:      rae.CategoryVerb,
+						VerbCategory:  &intransitive,
+						Usage:         rae.UsageCommon,
+						Description:   "Articular, proferir palabras para darse a entender.",
+						Synonyms:      []string{"decir", "expresar"},
+						Antonyms:      []string{"callar"},
+					},
+					{
+						Raw:           "2. tr. Emplear uno u otro idioma para darse a entender.",
+						MeaningNumber: 2,
+						Category:      rae.CategoryVerb,
+						VerbCategory:  &transitive,
+						Usage:         rae.UsageCommon,
+						Description:   "Emplear uno u otro idioma para darse a entender.",
+						Synonyms:      []string{},
+						Antonyms:      []string{},
+					},
+				},
+				Conjugations: &rae.Conjugations{
+					ConjugationNonPersonal: rae.ConjugationNonPersonal{
+						Infinitive:         "hablar",
+						Participle:         "hablado",
+						Gerund:             "hablando",
+						CompoundInfinitive: "haber hablado",
+						CompoundGerund:     "habiendo hablado",
+					},
+					ConjugationIndicative: rae.ConjugationIndicative{
+						Present: rae.Conjugation{
+							SingularFirstPerson:        "hablo",
+							SingularSecondPerson:       "hablas",
+							SingularFormalSecondPerson: "habla",
+							SingularThirdPerson:        "habla",
+							PluralFirstPerson:          "hablamos",
+							PluralSecondPerson:         "habláis",
+							PluralFormalSecondPerson:   "hablan",
+							PluralThirdPerson:          "hablan",
+						},
+					},
+					ConjugationImperative: rae.ConjugationImperative{
+						SingularSecondPerson:       "habla",
+						SingularFormalSecondPerson: "hable",
+						PluralSecondPerson:         "hablad",
+						PluralFormalSecondPerson:   "hablen",
+					},
+				},
+			}},
+		},
+		{
+			Word: "casa",
+			Meanings: []rae.Meaning{{
+				Origin: &rae.Origin{
+					Raw:  "Del lat. casa 'choza'.",
+					Type: rae.OriginLatin,
+					Text: "casa",
+				},
+				Definitions: []rae.Definition{
+					{
+						Raw:           "1. f. Edificio para habitar.",
+						MeaningNumber: 1,
+						Category:      rae.CategoryNoun,
+						Gender:        &feminine,
+						Usage:         rae.UsageCommon,
+						Description:   "Edificio para habitar.",
+						Synonyms:      []string{"vivienda", "hogar"},
+						Antonyms:      []string{},
+					},
+				},
+			}},
+		},
+		{
+			Word: "camión",
+			Meanings: []rae.Meaning{{
+				Origin: &rae.Origin{
+					Raw:  "Del fr. camion.",
+					Type: rae.OriginFrench,
+					Text: "camion",
+				},
+				Definitions: []rae.Definition{
+					{
+						Raw:           "1. m. Vehículo automóvil grande para transportar cargas.",
+						MeaningNumber: 1,
+						Category:      rae.CategoryNoun,
+						Gender:        &masculine,
+						Usage:         rae.UsageCommon,
+						Description:   "Vehículo automóvil grande para transportar cargas.",
+						Synonyms:      []string{},
+						Antonyms:      []string{},
+					},
+					{
+						Raw:           "2. m. Méx. autobús.",
+						MeaningNumber: 2,
+						Category:      rae.CategoryNoun,
+						Gender:        &masculine,
+						Usage:         rae.UsageCommon,
+						Description:   "autobús.",
+						Synonyms:      []string{"autobús"},
+						Antonyms:      []string{},
+						Regions:       []rae.Region{rae.RegionMexico},
+					},
+				},
+			}},
+		},
+	}
+}
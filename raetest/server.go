@@ -0,0 +1,157 @@
+// Package raetest provides a fake rae-api.com server for tests, in the
+// spirit of net/http/httptest.
+package raetest
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"sync"
+	"unicode/utf8"
+
+	rae "github.com/rae-api-com/go-rae"
+	"github.com/rae-api-com/go-rae/collate"
+)
+
+// Server is a fake rae-api.com serving a fixed set of entries. Point a
+// client to it with rae.WithBaseURL(server.URL).
+type Server struct {
+	*httptest.Server
+
+	entries map[string]rae.WordEntry
+	words   []string
+
+	mu      sync.Mutex
+	lookups map[string]int
+
+	// Daily is the word returned by /daily and /random. It defaults to the
+	// first entry.
+	Daily string
+}
+
+// NewServer starts a fake server serving entries, or Entries when none are
+// given. The caller must Close it.
+func NewServer(entries ...rae.WordEntry) *Server {
+	if len(entries) == 0 {
+		entries = Entries()
+	}
+
+	s := &Server{entries: map[string]rae.WordEntry{}, lookups: map[string]int{}}
+	for _, e := range entries {
+		s.entries[e.Word] = e
+		s.words = append(s.words, e.Word)
+	}
+	s.Daily = s.words[0]
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /words/{word}", s.word)
+	mux.HandleFunc("GET /search", s.search)
+	mux.HandleFunc("GET /random", s.single)
+	mux.HandleFunc("GET /daily", s.single)
+
+	s.Server = httptest.NewServer(mux)
+
+	return s
+}
+
+type envelope struct {
+	Ok          bool     `json:"ok"`
+	Data        any      `json:"data,omitempty"`
+	Err         string   `json:"error,omitempty"`
+	Suggestions []string `json:"suggestions,omitempty"`
+}
+
+// Lookups returns the number of times word was requested, found or not.
+func (s *Server) Lookups(word string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.lookups[word]
+}
+
+func (s *Server) word(w http.ResponseWriter, r *http.Request) {
+	word := r.PathValue("word")
+
+	s.mu.Lock()
+	s.lookups[word]++
+	s.mu.Unlock()
+
+	entry, ok := s.entries[word]
+	if !ok {
+		writeJSON(w, http.StatusNotFound, envelope{
+			Err:         "word not found",
+			Suggestions: s.suggestions(word),
+		})
+		return
+	}
+
+	writeJSON(w, http.StatusOK, envelope{Ok: true, Data: entry})
+}
+
+func (s *Server) search(w http.ResponseWriter, r *http.Request) {
+	q := strings.ToLower(r.URL.Query().Get("q"))
+
+	results := []rae.SearchResult{}
+	for _, word := range s.words {
+		entry := s.entries[word]
+
+		hits := strings.Count(strings.ToLower(entry.Word), q)
+		for _, m := range entry.Meanings {
+			for _, d := range m.Definitions {
+				hits += strings.Count(strings.ToLower(d.Description), q)
+			}
+		}
+		if q == "" || hits == 0 {
+			continue
+		}
+
+		raw, err := json.Marshal(entry)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		results = append(results, rae.SearchResult{
+			Doc:  rae.Document{Word: entry.Word, Raw: string(raw)},
+			Hits: hits,
+		})
+	}
+
+	sort.SliceStable(results, func(i, j int) bool {
+		return results[i].Hits > results[j].Hits
+	})
+
+	writeJSON(w, http.StatusOK, results)
+}
+
+func (s *Server) single(w http.ResponseWriter, _ *http.Request) {
+	writeJSON(w, http.StatusOK, envelope{Ok: true, Data: rae.WordSingle{Word: s.Daily}})
+}
+
+// suggestions returns the known words sharing the first letter of word,
+// ignoring accents.
+func (s *Server) suggestions(word string) []string {
+	suggestions := []string{}
+	if word == "" {
+		return suggestions
+	}
+	first := firstLetter(word)
+	for _, w := range s.words {
+		if firstLetter(w) == first {
+			suggestions = append(suggestions, w)
+		}
+	}
+	return suggestions
+}
+
+func firstLetter(word string) string {
+	r, _ := utf8.DecodeRuneInString(word)
+	return collate.Key(string(r))
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
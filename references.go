@@ -0,0 +1,112 @@
+package rae
+
+import (
+	"context"
+	"regexp"
+	"strings"
+)
+
+// ReferenceKind tells how a definition points to another headword.
+type ReferenceKind string
+
+const (
+	ReferenceSee     ReferenceKind = "see"     // V.
+	ReferenceCompare ReferenceKind = "compare" // Cf.
+	ReferenceArrow   ReferenceKind = "arrow"   // →
+)
+
+// CrossReference is a link from a definition to another headword. Entry is
+// only set when the client resolves references, see WithReferenceDepth.
+type CrossReference struct {
+	Kind   ReferenceKind `json:"kind"`
+	Target string        `json:"target"`
+	Entry  *WordEntry    `json:"entry,omitempty"`
+}
+
+var (
+	referencePattern = regexp.MustCompile(`(?:^|[.;]\s+)(V\.|Cf\.|→)\s*([^.;]+)`)
+	referenceSplit   = regexp.MustCompile(`\s*,\s*|\s+y\s+|\s+o\s+`)
+	referenceNoise   = regexp.MustCompile(`\s*\([^)]*\)|[¹²³⁴⁵⁶⁷⁸⁹⁰\d]+$`)
+)
+
+var referenceKinds = map[string]ReferenceKind{
+	"V.":  ReferenceSee,
+	"Cf.": ReferenceCompare,
+	"→":   ReferenceArrow,
+}
+
+// ParseReferences detects the "V.", "Cf." and "→" pointers in a definition
+// text and returns one reference per target headword.
+func ParseReferences(text string) []CrossReference {
+	var refs []CrossReference
+
+	for _, match := range referencePattern.FindAllStringSubmatch(text, -1) {
+		kind := referenceKinds[match[1]]
+		for _, target := range referenceSplit.Split(match[2], -1) {
+			target = strings.TrimSpace(referenceNoise.ReplaceAllString(target, ""))
+			if target == "" {
+				continue
+			}
+			refs = append(refs, CrossReference{Kind: kind, Target: target})
+		}
+	}
+
+	return refs
+}
+
+// resolveReferences fetches the targets of every reference in the entry up
+// to depth levels. Targets are normalised as by Word. path holds the words
+// being resolved, a reference back to any of them is a cycle and is left
+// unresolved. seen caches the entries already resolved so a word referenced
+// twice is requested once; entries still being resolved are never shared,
+// so the result holds no pointer cycles.
+func (c *Client) resolveReferences(
+	ctx context.Context,
+	entry *WordEntry,
+	depth int,
+	path map[string]bool,
+	seen map[string]*WordEntry,
+) {
+	if depth <= 0 {
+		return
+	}
+
+	word, err := normalizeWord(entry.Word)
+	if err != nil {
+		word = entry.Word
+	}
+	path[word] = true
+	defer delete(path, word)
+
+	for i := range entry.Meanings {
+		defs := entry.Meanings[i].Definitions
+		for j := range defs {
+			refs := defs[j].References
+			for k := range refs {
+				ref := &refs[k]
+				if ref.Entry != nil {
+					continue
+				}
+
+				target, err := normalizeWord(ref.Target)
+				if err != nil || path[target] {
+					continue
+				}
+
+				if cached, ok := seen[target]; ok {
+					ref.Entry = cached
+					continue
+				}
+
+				resolved, err := c.lookup(ctx, target)
+				if err != nil {
+					continue
+				}
+
+				c.resolveReferences(ctx, &resolved, depth-1, path, seen)
+				seen[target] = &resolved
+				ref.Entry = &resolved
+			}
+		}
+	}
+}
@@ -0,0 +1,39 @@
+package rae
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseReferences(t *testing.T) {
+	tests := []struct {
+		text string
+		want []CrossReference
+	}{
+		{
+			text: "V. hablar.",
+			want: []CrossReference{{Kind: ReferenceSee, Target: "hablar"}},
+		},
+		{
+			text: "Cf. banco², bancal y banqueta.",
+			want: []CrossReference{
+				{Kind: ReferenceCompare, Target: "banco"},
+				{Kind: ReferenceCompare, Target: "bancal"},
+				{Kind: ReferenceCompare, Target: "banqueta"},
+			},
+		},
+		{
+			text: "Persona que habla mucho. → charlatán (‖ hablador).",
+			want: []CrossReference{{Kind: ReferenceArrow, Target: "charlatán"}},
+		},
+		{
+			text: "Masticar y deglutir un alimento sólido.",
+		},
+	}
+
+	for _, tt := range tests {
+		if got := ParseReferences(tt.text); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%q: want %+v, got %+v", tt.text, tt.want, got)
+		}
+	}
+}
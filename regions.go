@@ -0,0 +1,211 @@
+package rae
+
+import "strings"
+
+// Region is the geographic scope of a sense, taken from the DLE country
+// markers ("Am.", "Méx.", "Esp.").
+type Region string
+
+const (
+	RegionAmerica           Region = "america"
+	RegionCentralAmerica    Region = "central_america"
+	RegionSouthAmerica      Region = "south_america"
+	RegionAntilles          Region = "antilles"
+	RegionAndalusia         Region = "andalusia"
+	RegionCanaryIslands     Region = "canary_islands"
+	RegionArgentina         Region = "argentina"
+	RegionBolivia           Region = "bolivia"
+	RegionChile             Region = "chile"
+	RegionColombia          Region = "colombia"
+	RegionCostaRica         Region = "costa_rica"
+	RegionCuba              Region = "cuba"
+	RegionDominicanRepublic Region = "dominican_republic"
+	RegionEcuador           Region = "ecuador"
+	RegionElSalvador        Region = "el_salvador"
+	RegionEquatorialGuinea  Region = "equatorial_guinea"
+	RegionGuatemala         Region = "guatemala"
+	RegionHonduras          Region = "honduras"
+	RegionMexico            Region = "mexico"
+	RegionNicaragua         Region = "nicaragua"
+	RegionPanama            Region = "panama"
+	RegionParaguay          Region = "paraguay"
+	RegionPeru              Region = "peru"
+	RegionPhilippines       Region = "philippines"
+	RegionPuertoRico        Region = "puerto_rico"
+	RegionSpain             Region = "spain"
+	RegionUnitedStates      Region = "united_states"
+	RegionUruguay           Region = "uruguay"
+	RegionVenezuela         Region = "venezuela"
+)
+
+// regionISO maps the regions to their ISO 3166-1 alpha-2 country codes and
+// the regions of Spain to their ISO 3166-2 subdivision codes.
+var regionISO = map[Region]string{
+	RegionAndalusia:         "ES-AN",
+	RegionCanaryIslands:     "ES-CN",
+	RegionArgentina:         "AR",
+	RegionBolivia:           "BO",
+	RegionChile:             "CL",
+	RegionColombia:          "CO",
+	RegionCostaRica:         "CR",
+	RegionCuba:              "CU",
+	RegionDominicanRepublic: "DO",
+	RegionEcuador:           "EC",
+	RegionElSalvador:        "SV",
+	RegionEquatorialGuinea:  "GQ",
+	RegionGuatemala:         "GT",
+	RegionHonduras:          "HN",
+	RegionMexico:            "MX",
+	RegionNicaragua:         "NI",
+	RegionPanama:            "PA",
+	RegionParaguay:          "PY",
+	RegionPeru:              "PE",
+	RegionPhilippines:       "PH",
+	RegionPuertoRico:        "PR",
+	RegionSpain:             "ES",
+	RegionUnitedStates:      "US",
+	RegionUruguay:           "UY",
+	RegionVenezuela:         "VE",
+}
+
+// regionGroups lists the countries covered by the supranational markers.
+var regionGroups = map[Region][]string{
+	RegionCentralAmerica: {"CR", "SV", "GT", "HN", "NI", "PA"},
+	RegionSouthAmerica:   {"AR", "BO", "CL", "CO", "EC", "PY", "PE", "UY", "VE"},
+	RegionAntilles:       {"CU", "DO", "PR"},
+	RegionAmerica: {
+		"AR", "BO", "CL", "CO", "CR", "CU", "DO", "EC", "SV", "GT",
+		"HN", "MX", "NI", "PA", "PY", "PE", "PR", "UY", "VE",
+	},
+}
+
+// ISO returns the ISO 3166-1 alpha-2 code of the region, the ISO 3166-2
+// code for regions within a country such as "ES-AN", or an empty string for
+// markers spanning several countries such as RegionAmerica.
+func (r Region) ISO() string {
+	return regionISO[r]
+}
+
+// Countries returns the ISO 3166-1 alpha-2 codes covered by the region.
+func (r Region) Countries() []string {
+	if countries, ok := regionGroups[r]; ok {
+		return countries
+	}
+	if code, ok := regionISO[r]; ok {
+		return []string{code}
+	}
+	return nil
+}
+
+// Covers reports whether the region applies to the given ISO 3166-1
+// alpha-2 country code. A sense marked "Am." covers "MX". Subdivisions only
+// cover their own code: "And." covers "ES-AN" but not "ES".
+func (r Region) Covers(iso string) bool {
+	for _, code := range r.Countries() {
+		if strings.EqualFold(code, iso) {
+			return true
+		}
+	}
+	return false
+}
+
+// Domain is the subject field of a sense, taken from the DLE markers such as
+// "Med." or "Der.".
+type Domain string
+
+const (
+	DomainAgriculture  Domain = "agriculture"
+	DomainAnatomy      Domain = "anatomy"
+	DomainArchitecture Domain = "architecture"
+	DomainAstronomy    Domain = "astronomy"
+	DomainBiology      Domain = "biology"
+	DomainBotany       Domain = "botany"
+	DomainBullfighting Domain = "bullfighting"
+	DomainChemistry    Domain = "chemistry"
+	DomainCinema       Domain = "cinema"
+	DomainCommerce     Domain = "commerce"
+	DomainComputing    Domain = "computing"
+	DomainEconomics    Domain = "economics"
+	DomainElectricity  Domain = "electricity"
+	DomainGeography    Domain = "geography"
+	DomainGeology      Domain = "geology"
+	DomainGeometry     Domain = "geometry"
+	DomainGrammar      Domain = "grammar"
+	DomainHeraldry     Domain = "heraldry"
+	DomainHorsemanship Domain = "horsemanship"
+	DomainHunting      Domain = "hunting"
+	DomainLaw          Domain = "law"
+	DomainLinguistics  Domain = "linguistics"
+	DomainLiterature   Domain = "literature"
+	DomainMathematics  Domain = "mathematics"
+	DomainMechanics    Domain = "mechanics"
+	DomainMedicine     Domain = "medicine"
+	DomainMetrics      Domain = "metrics"
+	DomainMilitary     Domain = "military"
+	DomainMusic        Domain = "music"
+	DomainMythology    Domain = "mythology"
+	DomainNautical     Domain = "nautical"
+	DomainOptics       Domain = "optics"
+	DomainPainting     Domain = "painting"
+	DomainPhilosophy   Domain = "philosophy"
+	DomainPhonetics    Domain = "phonetics"
+	DomainPhysics      Domain = "physics"
+	DomainPhysiology   Domain = "physiology"
+	DomainPrinting     Domain = "printing"
+	DomainPsychology   Domain = "psychology"
+	DomainReligion     Domain = "religion"
+	DomainRhetoric     Domain = "rhetoric"
+	DomainSports       Domain = "sports"
+	DomainTheatre      Domain = "theatre"
+	DomainTransport    Domain = "transport"
+	DomainVeterinary   Domain = "veterinary"
+	DomainZoology      Domain = "zoology"
+)
+
+// SensesForRegion returns the senses explicitly marked for the country with
+// the given ISO 3166-1 alpha-2 code, including those marked for a group of
+// countries containing it. Senses without a regional marker are not
+// returned.
+func (e WordEntry) SensesForRegion(iso string) []Definition {
+	var senses []Definition
+	for _, m := range e.Meanings {
+		for _, d := range m.Definitions {
+			for _, r := range d.Regions {
+				if r.Covers(iso) {
+					senses = append(senses, d)
+					break
+				}
+			}
+		}
+	}
+	return senses
+}
+
+// SensesForDomain returns the senses marked with the given subject field.
+func (e WordEntry) SensesForDomain(domain Domain) []Definition {
+	var senses []Definition
+	for _, m := range e.Meanings {
+		for _, d := range m.Definitions {
+			for _, dom := range d.Domains {
+				if dom == domain {
+					senses = append(senses, d)
+					break
+				}
+			}
+		}
+	}
+	return senses
+}
+
+func (d *Definition) fillScopes(labels RawLabels) {
+	if len(d.Regions) == 0 {
+		for _, v := range labels.Values(LabelRegion) {
+			d.Regions = append(d.Regions, Region(v))
+		}
+	}
+	if len(d.Domains) == 0 {
+		for _, v := range labels.Values(LabelDomain) {
+			d.Domains = append(d.Domains, Domain(v))
+		}
+	}
+}
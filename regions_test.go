@@ -0,0 +1,61 @@
+package rae
+
+import "testing"
+
+func TestSensesForRegion(t *testing.T) {
+	entry := WordEntry{
+		Word: "camión",
+		Meanings: []Meaning{{
+			Definitions: []Definition{
+				{MeaningNumber: 1, Raw: "1. m. Vehículo grande para transportar cargas."},
+				{MeaningNumber: 2, Raw: "2. m. Méx. Autobús."},
+				{MeaningNumber: 3, Raw: "3. m. Am. Cen. Autobús de línea."},
+				{MeaningNumber: 4, Raw: "4. m. Am. Der. Vehículo de uso judicial."},
+			},
+		}},
+	}
+	entry.Enrich()
+
+	tests := []struct {
+		iso  string
+		want []int
+	}{
+		{iso: "MX", want: []int{2, 4}},
+		{iso: "gt", want: []int{3, 4}},
+		{iso: "ES", want: nil},
+	}
+
+	for _, tt := range tests {
+		senses := entry.SensesForRegion(tt.iso)
+		if len(senses) != len(tt.want) {
+			t.Fatalf("%s: want %v senses, got %d", tt.iso, tt.want, len(senses))
+		}
+		for i, s := range senses {
+			if s.MeaningNumber != tt.want[i] {
+				t.Errorf("%s: want sense %d, got %d", tt.iso, tt.want[i], s.MeaningNumber)
+			}
+		}
+	}
+
+	if law := entry.SensesForDomain(DomainLaw); len(law) != 1 || law[0].MeaningNumber != 4 {
+		t.Errorf("expected sense 4 to be marked as law, got %v", law)
+	}
+}
+
+func TestRegionISO(t *testing.T) {
+	if RegionMexico.ISO() != "MX" {
+		t.Errorf("expected MX, got %q", RegionMexico.ISO())
+	}
+	if RegionAmerica.ISO() != "" {
+		t.Errorf("expected no single code for America, got %q", RegionAmerica.ISO())
+	}
+	if RegionAndalusia.ISO() != "ES-AN" || RegionCanaryIslands.ISO() != "ES-CN" {
+		t.Errorf("expected subdivision codes, got %q and %q", RegionAndalusia.ISO(), RegionCanaryIslands.ISO())
+	}
+	if RegionAndalusia.Covers("ES") || !RegionAndalusia.Covers("es-an") {
+		t.Error("unexpected coverage for Andalusia")
+	}
+	if !RegionAntilles.Covers("PR") || RegionAntilles.Covers("MX") {
+		t.Error("unexpected coverage for the Antilles")
+	}
+}
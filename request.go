@@ -0,0 +1,80 @@
+package rae
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/rae-api-com/go-rae/collate"
+)
+
+// MaxInputLength is the longest word or search query accepted, in runes.
+// The longest DLE headwords and locutions are well below it.
+const MaxInputLength = 100
+
+// InputError is returned when a word or search query is rejected before any
+// request is made. It unwraps to ErrInvalidInput.
+type InputError struct {
+	Kind   string // "word", "search" or "cursor"
+	Input  string
+	Reason string
+}
+
+func (e *InputError) Error() string {
+	return fmt.Sprintf("invalid %s %q: %s", e.Kind, e.Input, e.Reason)
+}
+
+func (e *InputError) Unwrap() error {
+	return ErrInvalidInput
+}
+
+// normalizeInput trims, converts to NFC and lowercases s, the form in which
+// the DLE writes its headwords, and rejects what cannot be sent.
+func normalizeInput(kind, s string) (string, error) {
+	if !utf8.ValidString(s) {
+		return "", &InputError{Kind: kind, Input: s, Reason: "not valid UTF-8"}
+	}
+
+	normalized := collate.Fold(s)
+
+	switch {
+	case normalized == "":
+		return "", &InputError{Kind: kind, Input: s, Reason: "empty"}
+	case utf8.RuneCountInString(normalized) > MaxInputLength:
+		return "", &InputError{
+			Kind:   kind,
+			Input:  s,
+			Reason: fmt.Sprintf("longer than %d characters", MaxInputLength),
+		}
+	case strings.ContainsFunc(normalized, unicode.IsControl):
+		return "", &InputError{Kind: kind, Input: s, Reason: "contains control characters"}
+	}
+
+	return normalized, nil
+}
+
+// normalizeWord is normalizeInput for headwords. A slash cannot be part of
+// a headword and would be read as a path separator by the server.
+func normalizeWord(word string) (string, error) {
+	normalized, err := normalizeInput("word", word)
+	if err != nil {
+		return "", err
+	}
+	if strings.Contains(normalized, "/") {
+		return "", &InputError{Kind: "word", Input: word, Reason: "contains a slash"}
+	}
+	return normalized, nil
+}
+
+func normalizeSearch(terms string) (string, error) {
+	return normalizeInput("search", terms)
+}
+
+// wordPath returns the escaped path of the entry of a normalised word. The
+// HTTP layer unescapes it once before sending, so it is encoded exactly
+// once on the wire.
+func wordPath(word string) string {
+	return "/words/" + url.PathEscape(word)
+}
@@ -0,0 +1,131 @@
+package rae
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// recorder is a local server remembering the last request it received.
+type recorder struct {
+	*httptest.Server
+	path     string
+	rawQuery string
+	q        string
+}
+
+func newRecorder(t *testing.T) *recorder {
+	r := &recorder{}
+	r.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		r.path = req.URL.EscapedPath()
+		r.rawQuery = req.URL.RawQuery
+		r.q = req.URL.Query().Get("q")
+
+		w.Header().Set("Content-Type", "application/json")
+		if strings.HasPrefix(req.URL.Path, "/search") {
+			w.Write([]byte(`[]`))
+			return
+		}
+		w.Write([]byte(`{"ok":false,"error":"not found"}`))
+	}))
+	t.Cleanup(r.Close)
+	return r
+}
+
+func TestWordRequest(t *testing.T) {
+	server := newRecorder(t)
+	cli := New(WithBaseURL(server.URL))
+
+	tests := []struct {
+		word string
+		path string
+	}{
+		{"hablar", "/words/hablar"},
+		{"  Hablar  ", "/words/hablar"},
+		{"ñandú", "/words/%C3%B1and%C3%BA"},
+		{"canción", "/words/canci%C3%B3n"},
+		{"a priori", "/words/a%20priori"},
+		{"qué?", "/words/qu%C3%A9%3F"},
+		{"50%", "/words/50%25"},
+		{"a#b", "/words/a%23b"},
+	}
+
+	for _, tt := range tests {
+		server.path = ""
+		cli.Word(context.Background(), tt.word)
+		if server.path != tt.path {
+			t.Errorf("%q: server received %q, want %q", tt.word, server.path, tt.path)
+		}
+	}
+}
+
+func TestSearchRequest(t *testing.T) {
+	server := newRecorder(t)
+	cli := New(WithBaseURL(server.URL))
+
+	tests := []struct {
+		terms    string
+		rawQuery string
+		q        string
+	}{
+		{"perro", "q=perro", "perro"},
+		{"Ñandú", "q=%C3%B1and%C3%BA", "ñandú"},
+		{"  a   la  buena ", "q=a+la+buena", "a la buena"},
+		{"sal & pimienta", "q=sal+%26+pimienta", "sal & pimienta"},
+		{"100%", "q=100%25", "100%"},
+	}
+
+	for _, tt := range tests {
+		if _, err := cli.Search(context.Background(), tt.terms); err != nil {
+			t.Errorf("%q: %v", tt.terms, err)
+			continue
+		}
+		if server.rawQuery != tt.rawQuery || server.q != tt.q {
+			t.Errorf(
+				"%q: server received %q (q=%q), want %q (q=%q)",
+				tt.terms, server.rawQuery, server.q, tt.rawQuery, tt.q,
+			)
+		}
+	}
+}
+
+func TestInvalidInput(t *testing.T) {
+	server := newRecorder(t)
+	cli := New(WithBaseURL(server.URL))
+
+	tests := []struct {
+		word   string
+		reason string
+	}{
+		{"", "empty"},
+		{"   ", "empty"},
+		{strings.Repeat("a", MaxInputLength+1), "longer than"},
+		{"a/b", "slash"},
+		{"a\x00b", "control"},
+		{"\xff", "UTF-8"},
+	}
+
+	for _, tt := range tests {
+		server.path = ""
+		_, err := cli.Word(context.Background(), tt.word)
+
+		var inputErr *InputError
+		if !errors.As(err, &inputErr) || !errors.Is(err, ErrInvalidInput) {
+			t.Errorf("%q: expected an *InputError, got %v", tt.word, err)
+			continue
+		}
+		if !strings.Contains(inputErr.Reason, tt.reason) {
+			t.Errorf("%q: unexpected reason %q", tt.word, inputErr.Reason)
+		}
+		if server.path != "" {
+			t.Errorf("%q: no request should be made, got %q", tt.word, server.path)
+		}
+	}
+
+	if _, err := cli.Search(context.Background(), " "); !errors.Is(err, ErrInvalidInput) {
+		t.Errorf("expected an invalid search to fail, got %v", err)
+	}
+}
@@ -0,0 +1,236 @@
+// Package reverse finds words by their definition, such as "claustrofobia"
+// for "miedo a los espacios cerrados", from a local set of entries.
+package reverse
+
+import (
+	"context"
+	"maps"
+	"math"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+
+	rae "github.com/rae-api-com/go-rae"
+	"github.com/rae-api-com/go-rae/analysis"
+	"github.com/rae-api-com/go-rae/collate"
+)
+
+// BM25 parameters.
+const (
+	k1 = 1.2
+	b  = 0.75
+)
+
+// Span is a byte range of a description.
+type Span struct {
+	Start int `json:"start"`
+	End   int `json:"end"`
+}
+
+// Candidate is an entry whose definition matches a description. Sense is
+// its best matching sense and Matches the words of the sense description
+// that match the query.
+type Candidate struct {
+	Entry   rae.WordEntry `json:"entry"`
+	Sense   rae.Sense     `json:"sense"`
+	Score   float64       `json:"score"`
+	Matches []Span        `json:"matches,omitempty"`
+}
+
+// Highlight returns the description of the sense with the matching words
+// wrapped in open and close, e.g. "<em>" and "</em>".
+func (c Candidate) Highlight(open, close string) string {
+	desc := c.Sense.Definition.Description
+
+	var sb strings.Builder
+	last := 0
+	for _, m := range c.Matches {
+		sb.WriteString(desc[last:m.Start])
+		sb.WriteString(open)
+		sb.WriteString(desc[m.Start:m.End])
+		sb.WriteString(close)
+		last = m.End
+	}
+	sb.WriteString(desc[last:])
+
+	return sb.String()
+}
+
+type sense struct {
+	entry      int
+	meaning    int
+	definition int
+	length     int
+}
+
+type posting struct {
+	sense int
+	freq  int
+}
+
+// Index is an inverted index over the sense descriptions of entries. It is
+// safe for concurrent use.
+type Index struct {
+	// Limit is the number of candidates returned by ReverseLookup, 10 when
+	// zero.
+	Limit int
+
+	mu       sync.RWMutex
+	entries  []rae.WordEntry
+	words    map[string]bool
+	senses   []sense
+	postings map[string][]posting
+	totalLen int
+}
+
+// NewIndex returns an index over entries.
+func NewIndex(entries ...rae.WordEntry) *Index {
+	idx := &Index{}
+	idx.Add(entries...)
+	return idx
+}
+
+// Add indexes the senses of entries, enriched as by rae.WordEntry.Enrich.
+// Entries whose headword is already in the index are skipped.
+func (idx *Index) Add(entries ...rae.WordEntry) {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+
+	if idx.words == nil {
+		idx.words = map[string]bool{}
+		idx.postings = map[string][]posting{}
+	}
+
+	for _, e := range entries {
+		if idx.words[e.Word] {
+			continue
+		}
+		e = e.Clone()
+		e.Enrich()
+
+		idx.words[e.Word] = true
+		idx.entries = append(idx.entries, e)
+
+		for i, m := range e.Meanings {
+			for j, d := range m.Definitions {
+				terms := analysis.Terms(d.Description)
+				if len(terms) == 0 {
+					continue
+				}
+
+				id := len(idx.senses)
+				idx.senses = append(idx.senses, sense{
+					entry:      len(idx.entries) - 1,
+					meaning:    i,
+					definition: j,
+					length:     len(terms),
+				})
+				idx.totalLen += len(terms)
+
+				freqs := map[string]int{}
+				for _, t := range terms {
+					freqs[t]++
+				}
+				for t, f := range freqs {
+					idx.postings[t] = append(idx.postings[t], posting{sense: id, freq: f})
+				}
+			}
+		}
+	}
+}
+
+// Len returns the number of entries in the index.
+func (idx *Index) Len() int {
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+	return len(idx.entries)
+}
+
+// ReverseLookup returns the entries whose senses best match description,
+// ranked with BM25 over the analysed sense descriptions. Every entry
+// appears once, scored by its best sense.
+func (idx *Index) ReverseLookup(ctx context.Context, description string) ([]Candidate, error) {
+	limit := idx.Limit
+	if limit <= 0 {
+		limit = 10
+	}
+
+	query := map[string]bool{}
+	for _, t := range analysis.Terms(description) {
+		query[t] = true
+	}
+
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+
+	if len(idx.senses) == 0 || len(query) == 0 {
+		return nil, nil
+	}
+
+	n := float64(len(idx.senses))
+	avgLen := float64(idx.totalLen) / n
+
+	scores := map[int]float64{}
+	for _, t := range slices.Sorted(maps.Keys(query)) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		postings := idx.postings[t]
+		df := float64(len(postings))
+		idf := math.Log(1 + (n-df+0.5)/(df+0.5))
+
+		for _, p := range postings {
+			tf := float64(p.freq)
+			norm := k1 * (1 - b + b*float64(idx.senses[p.sense].length)/avgLen)
+			scores[p.sense] += idf * tf * (k1 + 1) / (tf + norm)
+		}
+	}
+
+	best := map[int]int{} // entry to its best sense
+	for s, score := range scores {
+		e := idx.senses[s].entry
+		if cur, ok := best[e]; !ok || score > scores[cur] || (score == scores[cur] && s < cur) {
+			best[e] = s
+		}
+	}
+
+	candidates := make([]Candidate, 0, len(best))
+	for e, s := range best {
+		ref := idx.senses[s]
+		entry := idx.entries[e]
+		m := entry.Meanings[ref.meaning]
+		d := m.Definitions[ref.definition]
+
+		candidates = append(candidates, Candidate{
+			Entry:   entry,
+			Sense:   rae.Sense{Meaning: m, Definition: d},
+			Score:   scores[s],
+			Matches: matches(d.Description, query),
+		})
+	}
+
+	sort.Slice(candidates, func(i, j int) bool {
+		if candidates[i].Score != candidates[j].Score {
+			return candidates[i].Score > candidates[j].Score
+		}
+		return collate.Compare(candidates[i].Entry.Word, candidates[j].Entry.Word) < 0
+	})
+
+	if len(candidates) > limit {
+		candidates = candidates[:limit]
+	}
+
+	return candidates, nil
+}
+
+func matches(description string, query map[string]bool) []Span {
+	var spans []Span
+	for _, t := range analysis.Analyze(description) {
+		if query[t.Term] {
+			spans = append(spans, Span{Start: t.Start, End: t.End})
+		}
+	}
+	return spans
+}
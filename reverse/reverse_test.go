@@ -0,0 +1,110 @@
+package reverse
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	rae "github.com/rae-api-com/go-rae"
+	"github.com/rae-api-com/go-rae/raetest"
+)
+
+func entry(word string, descriptions ...string) rae.WordEntry {
+	var definitions []rae.Definition
+	for i, d := range descriptions {
+		definitions = append(definitions, rae.Definition{
+			MeaningNumber: i + 1,
+			Category:      rae.CategoryNoun,
+			Description:   d,
+		})
+	}
+	return rae.WordEntry{Word: word, Meanings: []rae.Meaning{{Definitions: definitions}}}
+}
+
+func testIndex() *Index {
+	return NewIndex(append(raetest.Entries(),
+		entry("claustrofobia", "Fobia a los espacios cerrados."),
+		entry("agorafobia", "Fobia a los espacios abiertos, como las plazas o las avenidas."),
+		entry("miedo",
+			"Angustia por un riesgo o daño real o imaginario.",
+			"Recelo o aprensión que alguien tiene de que le suceda algo contrario a lo que desea."),
+	)...)
+}
+
+func TestReverseLookup(t *testing.T) {
+	idx := testIndex()
+
+	got, err := idx.ReverseLookup(context.Background(), "miedo a los espacios cerrados")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 2 || got[0].Entry.Word != "claustrofobia" || got[1].Entry.Word != "agorafobia" {
+		t.Fatalf("got %v", words(got))
+	}
+	if got[0].Score <= got[1].Score {
+		t.Errorf("scores not ranked: %v, %v", got[0].Score, got[1].Score)
+	}
+
+	if h := got[0].Highlight("[", "]"); h != "Fobia a los [espacios] [cerrados]." {
+		t.Errorf("highlight: %q", h)
+	}
+
+	got, err = idx.ReverseLookup(context.Background(), "recelo de que algo suceda")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 1 || got[0].Entry.Word != "miedo" || got[0].Sense.Definition.MeaningNumber != 2 {
+		t.Fatalf("best sense: got %+v", got)
+	}
+}
+
+func TestReverseLookupEdgeCases(t *testing.T) {
+	idx := testIndex()
+	if idx.Len() != len(raetest.Entries())+3 {
+		t.Errorf("Len = %d", idx.Len())
+	}
+
+	idx.Add(entry("miedo", "duplicado"))
+	if idx.Len() != len(raetest.Entries())+3 {
+		t.Error("duplicate headwords should be skipped")
+	}
+
+	if got, err := idx.ReverseLookup(context.Background(), "de la y"); err != nil || len(got) != 0 {
+		t.Errorf("stop words only: %v, %v", got, err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := idx.ReverseLookup(ctx, "espacios"); err == nil {
+		t.Error("cancelled context should fail")
+	}
+
+	idx.Limit = 1
+	if got, _ := idx.ReverseLookup(context.Background(), "espacios"); len(got) != 1 {
+		t.Errorf("limit: got %v", words(got))
+	}
+}
+
+func words(candidates []Candidate) []string {
+	var out []string
+	for _, c := range candidates {
+		out = append(out, c.Entry.Word)
+	}
+	return out
+}
+
+func TestAddEnrichesEntries(t *testing.T) {
+	guagua := entry("guagua", "Autobús.")
+	guagua.Meanings[0].Definitions[0].Raw = "1. f. Ant. Autobús."
+
+	got, err := NewIndex(guagua).ReverseLookup(context.Background(), "autobús")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 1 || !slices.Contains(got[0].Sense.Definition.Regions, rae.RegionAntilles) {
+		t.Fatalf("expected the region in the raw definition to be parsed, got %+v", got)
+	}
+	if guagua.Meanings[0].Definitions[0].Regions != nil {
+		t.Error("the entry given to Add was changed")
+	}
+}
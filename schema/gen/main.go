@@ -0,0 +1,28 @@
+// Command gen writes the JSON Schema and OpenAPI documents of package schema
+// to the current directory. It is run by go generate.
+package main
+
+import (
+	"log"
+	"os"
+
+	"github.com/rae-api-com/go-rae/schema"
+)
+
+func main() {
+	jsonSchema, err := schema.JSONSchema()
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(schema.JSONSchemaFile, jsonSchema, 0o644); err != nil {
+		log.Fatal(err)
+	}
+
+	openAPI, err := schema.OpenAPI()
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(schema.OpenAPIFile, openAPI, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
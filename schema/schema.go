@@ -0,0 +1,269 @@
+// Package schema derives a JSON Schema and an OpenAPI 3 description of the
+// rae-api.com entity model from the Go types of package rae.
+package schema
+
+import (
+	"encoding/json"
+	"reflect"
+	"sort"
+	"strings"
+
+	rae "github.com/rae-api-com/go-rae"
+)
+
+//go:generate go run ./gen
+
+const (
+	JSONSchemaFile = "entities.schema.json"
+	OpenAPIFile    = "openapi.json"
+)
+
+// entities are the root types of the model. Every type reachable from them
+// gets its own definition.
+var entities = []any{
+	rae.WordEntry{},
+	rae.SearchResult{},
+	rae.WordSingle{},
+}
+
+type builder struct {
+	refPrefix string
+	defs      map[string]any
+}
+
+func newBuilder(refPrefix string) *builder {
+	return &builder{refPrefix: refPrefix, defs: map[string]any{}}
+}
+
+func (b *builder) ref(name string) map[string]any {
+	return map[string]any{"$ref": b.refPrefix + name}
+}
+
+// schemaOf returns the schema of typ, registering named structs and enums
+// as definitions and returning a reference to them.
+func (b *builder) schemaOf(typ reflect.Type) map[string]any {
+	for typ.Kind() == reflect.Pointer {
+		typ = typ.Elem()
+	}
+
+	switch typ.Kind() {
+	case reflect.Struct:
+		name := typ.Name()
+		if _, ok := b.defs[name]; !ok {
+			b.defs[name] = nil // placeholder for recursive types
+			b.defs[name] = b.object(typ)
+		}
+		return b.ref(name)
+
+	case reflect.Slice:
+		return map[string]any{"type": "array", "items": b.schemaOf(typ.Elem())}
+
+	case reflect.Bool:
+		return map[string]any{"type": "boolean"}
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return map[string]any{"type": "integer"}
+
+	case reflect.Float32, reflect.Float64:
+		return map[string]any{"type": "number"}
+
+	case reflect.String:
+		if typ.PkgPath() == "" {
+			return map[string]any{"type": "string"}
+		}
+		name := typ.Name()
+		if _, ok := b.defs[name]; !ok {
+			def := map[string]any{"type": "string"}
+			if values := enumValues(typ); len(values) > 0 {
+				def["enum"] = values
+			}
+			b.defs[name] = def
+		}
+		return b.ref(name)
+	}
+
+	return map[string]any{}
+}
+
+func (b *builder) object(typ reflect.Type) map[string]any {
+	properties := map[string]any{}
+	required := []string{}
+
+	for i := 0; i < typ.NumField(); i++ {
+		f := typ.Field(i)
+		if !f.IsExported() {
+			continue
+		}
+
+		tag := f.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+
+		name, opts, _ := strings.Cut(tag, ",")
+		if name == "" {
+			name = f.Name
+		}
+
+		properties[name] = b.schemaOf(f.Type)
+		if !strings.Contains(opts, "omitempty") && !rae.OptionalField(typ, name) {
+			required = append(required, name)
+		}
+	}
+
+	sort.Strings(required)
+
+	obj := map[string]any{
+		"type":       "object",
+		"properties": properties,
+	}
+	if len(required) > 0 {
+		obj["required"] = required
+	}
+
+	return obj
+}
+
+// enumValues calls the Values method of the enum types of package rae.
+func enumValues(typ reflect.Type) []string {
+	method := reflect.Zero(typ).MethodByName("Values")
+	if !method.IsValid() || method.Type().NumIn() != 0 || method.Type().NumOut() != 1 {
+		return nil
+	}
+
+	out := method.Call(nil)[0]
+	if out.Kind() != reflect.Slice {
+		return nil
+	}
+
+	values := make([]string, out.Len())
+	for i := range values {
+		values[i] = out.Index(i).String()
+	}
+
+	return values
+}
+
+func (b *builder) envelope(data map[string]any) map[string]any {
+	return map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"ok":          map[string]any{"type": "boolean"},
+			"data":        data,
+			"error":       map[string]any{"type": "string"},
+			"suggestions": map[string]any{"type": "array", "items": map[string]any{"type": "string"}},
+		},
+		"required": []string{"ok"},
+	}
+}
+
+// JSONSchema returns a JSON Schema (draft 2020-12) document defining every
+// entity under $defs.
+func JSONSchema() ([]byte, error) {
+	b := newBuilder("#/$defs/")
+	for _, e := range entities {
+		b.schemaOf(reflect.TypeOf(e))
+	}
+
+	return marshal(map[string]any{
+		"$schema": "https://json-schema.org/draft/2020-12/schema",
+		"$id":     "https://rae-api.com/schema/entities.schema.json",
+		"title":   "rae-api.com entities",
+		"$defs":   b.defs,
+	})
+}
+
+// OpenAPI returns an OpenAPI 3 document for the endpoints used by the
+// client.
+func OpenAPI() ([]byte, error) {
+	b := newBuilder("#/components/schemas/")
+
+	wordEntry := b.schemaOf(reflect.TypeOf(rae.WordEntry{}))
+	wordSingle := b.schemaOf(reflect.TypeOf(rae.WordSingle{}))
+	searchResult := b.schemaOf(reflect.TypeOf(rae.SearchResult{}))
+
+	b.defs["WordEntryResponse"] = b.envelope(wordEntry)
+	b.defs["WordResponse"] = b.envelope(wordSingle)
+
+	jsonContent := func(schema map[string]any) map[string]any {
+		return map[string]any{
+			"application/json": map[string]any{"schema": schema},
+		}
+	}
+	response := func(description string, schema map[string]any) map[string]any {
+		return map[string]any{"description": description, "content": jsonContent(schema)}
+	}
+	get := func(operationID, summary string, params []any, responses map[string]any) map[string]any {
+		op := map[string]any{
+			"operationId": operationID,
+			"summary":     summary,
+			"responses":   responses,
+		}
+		if len(params) > 0 {
+			op["parameters"] = params
+		}
+		return map[string]any{"get": op}
+	}
+
+	paths := map[string]any{
+		"/words/{word}": get(
+			"getWord",
+			"Look up a headword",
+			[]any{map[string]any{
+				"name":     "word",
+				"in":       "path",
+				"required": true,
+				"schema":   map[string]any{"type": "string"},
+			}},
+			map[string]any{
+				"200": response("The entry of the word", b.ref("WordEntryResponse")),
+				"404": response("The word was not found, with suggestions", b.ref("WordEntryResponse")),
+			},
+		),
+		"/search": get(
+			"search",
+			"Search headwords",
+			[]any{map[string]any{
+				"name":     "q",
+				"in":       "query",
+				"required": true,
+				"schema":   map[string]any{"type": "string"},
+			}},
+			map[string]any{
+				"200": response("Matching documents", map[string]any{"type": "array", "items": searchResult}),
+			},
+		),
+		"/random": get(
+			"getRandom",
+			"Get a random word",
+			nil,
+			map[string]any{"200": response("A random word", b.ref("WordResponse"))},
+		),
+		"/daily": get(
+			"getDaily",
+			"Get the word of the day",
+			nil,
+			map[string]any{"200": response("The word of the day", b.ref("WordResponse"))},
+		),
+	}
+
+	return marshal(map[string]any{
+		"openapi": "3.0.3",
+		"info": map[string]any{
+			"title":   "rae-api.com",
+			"version": "1",
+		},
+		"servers":    []any{map[string]any{"url": "https://rae-api.com/api"}},
+		"paths":      paths,
+		"components": map[string]any{"schemas": b.defs},
+	})
+}
+
+func marshal(v any) ([]byte, error) {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append(data, '\n'), nil
+}
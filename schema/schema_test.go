@@ -0,0 +1,35 @@
+package schema
+
+import (
+	"bytes"
+	"os"
+	"testing"
+)
+
+// TestGeneratedFilesInSync fails when the Go structs changed without
+// running go generate in this package.
+func TestGeneratedFilesInSync(t *testing.T) {
+	tests := []struct {
+		file     string
+		generate func() ([]byte, error)
+	}{
+		{JSONSchemaFile, JSONSchema},
+		{OpenAPIFile, OpenAPI},
+	}
+
+	for _, tt := range tests {
+		want, err := tt.generate()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		got, err := os.ReadFile(tt.file)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !bytes.Equal(got, want) {
+			t.Errorf("%s is out of date, run go generate ./schema", tt.file)
+		}
+	}
+}
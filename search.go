@@ -0,0 +1,182 @@
+package rae
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"hash/fnv"
+	"strconv"
+	"strings"
+
+	"github.com/rae-api-com/go-rae/collate"
+)
+
+// SearchMode tells how the terms of a SearchQuery match headwords.
+type SearchMode string
+
+const (
+	SearchAny    SearchMode = ""       // whatever the API matches, definitions included
+	SearchExact  SearchMode = "exact"  // the headword is the terms, ignoring accents and case
+	SearchPrefix SearchMode = "prefix" // the headword starts with the terms
+)
+
+// SearchQuery is a structured search. The API only takes the terms, as the
+// "q" parameter; the mode, the categories and the page are applied to its
+// results by the client.
+type SearchQuery struct {
+	Terms      string
+	Mode       SearchMode
+	Categories []WordCategory // keep entries with a sense of any of them
+
+	// Limit is the size of a page, 10 when zero. The page starts at Offset
+	// or, when set, at the Cursor of the previous page.
+	Limit  int
+	Offset int
+	Cursor string
+}
+
+// SearchPage is a page of the results of a SearchQuery, or of a query over
+// a local index such as fulltext.Index. Total counts every result after
+// filtering and NextCursor, empty on the last page, fetches the next one.
+type SearchPage struct {
+	Results    []SearchResult `json:"results"`
+	Total      int            `json:"total"`
+	Offset     int            `json:"offset"`
+	NextCursor string         `json:"next_cursor,omitempty"`
+}
+
+func (p SearchPage) More() bool {
+	return p.NextCursor != ""
+}
+
+// SearchQuery runs q and returns the requested page.
+func (c *Client) SearchQuery(ctx context.Context, q SearchQuery) (SearchPage, error) {
+	offset, err := q.offset()
+	if err != nil {
+		return SearchPage{}, err
+	}
+
+	results, err := c.Search(ctx, q.Terms)
+	if err != nil {
+		return SearchPage{}, err
+	}
+
+	return q.page(results, offset)
+}
+
+// GetSearchQuery is SearchQuery for a client with default options.
+func GetSearchQuery(ctx context.Context, version string, q SearchQuery) (SearchPage, error) {
+	offset, err := q.offset()
+	if err != nil {
+		return SearchPage{}, err
+	}
+
+	results, _, err := getSearch(ctx, raeApi, version, q.Terms)
+	if err != nil {
+		return SearchPage{}, err
+	}
+
+	return q.page(results, offset)
+}
+
+func (q SearchQuery) page(results []SearchResult, offset int) (SearchPage, error) {
+	filtered, err := q.filter(results)
+	if err != nil {
+		return SearchPage{}, err
+	}
+
+	limit := q.Limit
+	if limit <= 0 {
+		limit = 10
+	}
+
+	page := SearchPage{Total: len(filtered), Offset: offset}
+	if offset < len(filtered) {
+		end := min(offset+limit, len(filtered))
+		page.Results = filtered[offset:end]
+		if end < len(filtered) {
+			page.NextCursor = q.cursor(end)
+		}
+	}
+
+	return page, nil
+}
+
+func (q SearchQuery) filter(results []SearchResult) ([]SearchResult, error) {
+	switch q.Mode {
+	case SearchAny, SearchExact, SearchPrefix:
+	default:
+		return nil, &InputError{Kind: "search", Input: q.Terms, Reason: fmt.Sprintf("unknown mode %q", q.Mode)}
+	}
+
+	if q.Mode == SearchAny && len(q.Categories) == 0 {
+		return results, nil
+	}
+
+	key := collate.Key(q.Terms)
+
+	var out []SearchResult
+	for _, r := range results {
+		switch q.Mode {
+		case SearchExact:
+			if collate.Key(r.Doc.Word) != key {
+				continue
+			}
+		case SearchPrefix:
+			if !strings.HasPrefix(collate.Key(r.Doc.Word), key) {
+				continue
+			}
+		}
+
+		if len(q.Categories) > 0 {
+			entry, err := r.WordEntry()
+			if err != nil {
+				return nil, fmt.Errorf("decoding search result %q: %w", r.Doc.Word, err)
+			}
+			if entry.Senses().Category(q.Categories...).Count() == 0 {
+				continue
+			}
+		}
+
+		out = append(out, r)
+	}
+
+	return out, nil
+}
+
+// cursor encodes the offset of the next page along with a fingerprint of
+// the query, so that a cursor is not used with another query by mistake.
+func (q SearchQuery) cursor(offset int) string {
+	return base64.RawURLEncoding.EncodeToString(
+		fmt.Appendf(nil, "%d:%x", offset, q.fingerprint()),
+	)
+}
+
+func (q SearchQuery) offset() (int, error) {
+	if q.Cursor == "" {
+		return max(q.Offset, 0), nil
+	}
+
+	invalid := &InputError{Kind: "cursor", Input: q.Cursor, Reason: "not a cursor of this query"}
+
+	raw, err := base64.RawURLEncoding.DecodeString(q.Cursor)
+	if err != nil {
+		return 0, invalid
+	}
+	offset, fingerprint, ok := strings.Cut(string(raw), ":")
+	if !ok || fingerprint != fmt.Sprintf("%x", q.fingerprint()) {
+		return 0, invalid
+	}
+	n, err := strconv.Atoi(offset)
+	if err != nil || n < 0 {
+		return 0, invalid
+	}
+
+	return n, nil
+}
+
+func (q SearchQuery) fingerprint() uint32 {
+	h := fnv.New32a()
+	fmt.Fprintf(h, "%s\x00%s\x00%v\x00%d", collate.Fold(q.Terms), q.Mode, q.Categories, q.Limit)
+	return h.Sum32()
+}
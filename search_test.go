@@ -0,0 +1,83 @@
+package rae_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	rae "github.com/rae-api-com/go-rae"
+	"github.com/rae-api-com/go-rae/raetest"
+)
+
+func TestSearchQuery(t *testing.T) {
+	server := raetest.NewServer()
+	defer server.Close()
+
+	cli := rae.New(rae.WithBaseURL(server.URL))
+	ctx := context.Background()
+
+	words := func(p rae.SearchPage) []string {
+		var out []string
+		for _, r := range p.Results {
+			out = append(out, r.Doc.Word)
+		}
+		return out
+	}
+
+	tests := []struct {
+		name  string
+		q     rae.SearchQuery
+		total int
+	}{
+		{"any", rae.SearchQuery{Terms: "a"}, 3},
+		{"exact", rae.SearchQuery{Terms: "Camión", Mode: rae.SearchExact}, 1},
+		{"prefix", rae.SearchQuery{Terms: "ca", Mode: rae.SearchPrefix}, 2},
+		{"category", rae.SearchQuery{Terms: "a", Categories: []rae.WordCategory{rae.CategoryVerb}}, 1},
+	}
+
+	for _, tt := range tests {
+		page, err := cli.SearchQuery(ctx, tt.q)
+		if err != nil {
+			t.Fatalf("%s: %v", tt.name, err)
+		}
+		if page.Total != tt.total || len(page.Results) != tt.total || page.More() {
+			t.Errorf("%s: got %d of %d: %v", tt.name, len(page.Results), page.Total, words(page))
+		}
+	}
+
+	// paginate with the cursor
+	q := rae.SearchQuery{Terms: "a", Limit: 2}
+	first, err := cli.SearchQuery(ctx, q)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if first.Total != 3 || len(first.Results) != 2 || !first.More() {
+		t.Fatalf("first page: %+v", first)
+	}
+
+	q.Cursor = first.NextCursor
+	second, err := cli.SearchQuery(ctx, q)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if second.Offset != 2 || len(second.Results) != 1 || second.More() {
+		t.Fatalf("second page: %+v", second)
+	}
+	for _, w := range words(first) {
+		if w == second.Results[0].Doc.Word {
+			t.Errorf("%q is on both pages", w)
+		}
+	}
+
+	// a cursor is only valid for its query
+	other := rae.SearchQuery{Terms: "casa", Limit: 2, Cursor: first.NextCursor}
+	if _, err := cli.SearchQuery(ctx, other); !errors.Is(err, rae.ErrInvalidInput) {
+		t.Errorf("foreign cursor: got %v", err)
+	}
+	if _, err := cli.SearchQuery(ctx, rae.SearchQuery{Terms: "a", Cursor: "%%%"}); !errors.Is(err, rae.ErrInvalidInput) {
+		t.Errorf("malformed cursor: got %v", err)
+	}
+	if _, err := cli.SearchQuery(ctx, rae.SearchQuery{Terms: "a", Mode: "fuzzy"}); !errors.Is(err, rae.ErrInvalidInput) {
+		t.Errorf("unknown mode: got %v", err)
+	}
+}
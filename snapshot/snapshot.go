@@ -0,0 +1,114 @@
+// Package snapshot reads and writes collections of entries stored on disk,
+// either as a JSON array or as JSON Lines with one entry per line.
+package snapshot
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+
+	rae "github.com/rae-api-com/go-rae"
+)
+
+// Read decodes the entries of r. The format is detected from the first
+// non-blank byte: '[' starts a JSON array, anything else is JSON Lines.
+func Read(r io.Reader) ([]rae.WordEntry, error) {
+	br := bufio.NewReader(r)
+
+	first, err := peekNonSpace(br)
+	if err == io.EOF {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if first == '[' {
+		var entries []rae.WordEntry
+		if err := json.NewDecoder(br).Decode(&entries); err != nil {
+			return nil, err
+		}
+		return entries, nil
+	}
+
+	var entries []rae.WordEntry
+
+	scanner := bufio.NewScanner(br)
+	scanner.Buffer(make([]byte, 64*1024), 16*1024*1024)
+	for line := 1; scanner.Scan(); line++ {
+		data := bytes.TrimSpace(scanner.Bytes())
+		if len(data) == 0 {
+			continue
+		}
+
+		var entry rae.WordEntry
+		if err := entry.UnmarshalJSON(data); err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		entries = append(entries, entry)
+	}
+
+	return entries, scanner.Err()
+}
+
+func peekNonSpace(br *bufio.Reader) (byte, error) {
+	for {
+		b, err := br.ReadByte()
+		if err != nil {
+			return 0, err
+		}
+		switch b {
+		case ' ', '\t', '\r', '\n':
+			continue
+		}
+		return b, br.UnreadByte()
+	}
+}
+
+// ReadFile reads the snapshot stored at path.
+func ReadFile(path string) ([]rae.WordEntry, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	entries, err := Read(f)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+
+	return entries, nil
+}
+
+// Write encodes entries as JSON Lines.
+func Write(w io.Writer, entries []rae.WordEntry) error {
+	bw := bufio.NewWriter(w)
+	for _, entry := range entries {
+		data, err := entry.MarshalJSON()
+		if err != nil {
+			return err
+		}
+		bw.Write(data)
+		bw.WriteByte('\n')
+	}
+	return bw.Flush()
+}
+
+// WriteFile writes entries as JSON Lines to path.
+func WriteFile(path string, entries []rae.WordEntry) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+
+	if err := Write(f, entries); err != nil {
+		f.Close()
+		return err
+	}
+
+	return f.Close()
+}
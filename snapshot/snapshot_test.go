@@ -0,0 +1,54 @@
+package snapshot
+
+import (
+	"bytes"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/rae-api-com/go-rae/raetest"
+)
+
+func TestRoundTrip(t *testing.T) {
+	entries := raetest.Entries()
+
+	path := filepath.Join(t.TempDir(), "entries.jsonl")
+	if err := WriteFile(path, entries); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(got) != len(entries) {
+		t.Fatalf("expected %d entries, got %d", len(entries), len(got))
+	}
+	for i := range got {
+		if got[i].Word != entries[i].Word {
+			t.Errorf("entry %d: expected %q, got %q", i, entries[i].Word, got[i].Word)
+		}
+	}
+}
+
+func TestReadArray(t *testing.T) {
+	got, err := Read(strings.NewReader(`
+		[{"word":"casa","meanings":[]},{"word":"perro","meanings":[]}]`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 2 || got[1].Word != "perro" {
+		t.Fatalf("unexpected entries: %+v", got)
+	}
+}
+
+func TestReadLineError(t *testing.T) {
+	var buf bytes.Buffer
+	buf.WriteString(`{"word":"casa","meanings":[]}` + "\n\n{oops\n")
+
+	_, err := Read(&buf)
+	if err == nil || !strings.Contains(err.Error(), "line 3") {
+		t.Fatalf("expected an error on line 3, got %v", err)
+	}
+}
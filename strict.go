@@ -0,0 +1,254 @@
+package rae
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// SchemaReport lists the differences between a response and the entity
+// model. Fields are reported as JSON paths such as
+// "data.meanings[0].senses[1].examples".
+type SchemaReport struct {
+	Endpoint      string         `json:"endpoint"`
+	UnknownFields []string       `json:"unknown_fields,omitempty"`
+	MissingFields []string       `json:"missing_fields,omitempty"`
+	UnknownValues []UnknownValue `json:"unknown_values,omitempty"`
+	DecodeError   string         `json:"decode_error,omitempty"`
+}
+
+// Empty reports whether the response matched the entity model.
+func (r SchemaReport) Empty() bool {
+	return len(r.UnknownFields) == 0 &&
+		len(r.MissingFields) == 0 &&
+		len(r.UnknownValues) == 0 &&
+		r.DecodeError == ""
+}
+
+// SchemaDriftError is returned by strict clients configured to fail when a
+// response does not match the entity model.
+type SchemaDriftError struct {
+	Report SchemaReport
+}
+
+func (e *SchemaDriftError) Error() string {
+	return fmt.Sprintf(
+		"%s: response does not match the schema (%d unknown fields, %d missing fields, %d unknown values)",
+		e.Report.Endpoint,
+		len(e.Report.UnknownFields),
+		len(e.Report.MissingFields),
+		len(e.Report.UnknownValues),
+	)
+}
+
+func (e *SchemaDriftError) Unwrap() error {
+	return ErrSchemaDrift
+}
+
+// StrictMode configures how a client reacts to schema drift. OnReport is
+// called for every response with a non-empty report, which is the place to
+// log or increment metrics. When Fail is set the call returns a
+// *SchemaDriftError along with the decoded value.
+type StrictMode struct {
+	OnReport func(SchemaReport)
+	Fail     bool
+}
+
+func (s *StrictMode) handle(report SchemaReport) error {
+	if report.Empty() {
+		return nil
+	}
+	if s.OnReport != nil {
+		s.OnReport(report)
+	}
+	if s.Fail {
+		return &SchemaDriftError{Report: report}
+	}
+	return nil
+}
+
+// checkEnvelope checks a response wrapped in ApiResponse, whose data is
+// expected to match dataType when ok is true.
+func checkEnvelope(endpoint string, raw []byte, dataType reflect.Type) SchemaReport {
+	report := SchemaReport{Endpoint: endpoint}
+
+	var envelope map[string]any
+	if err := json.Unmarshal(raw, &envelope); err != nil {
+		report.DecodeError = err.Error()
+		return report
+	}
+
+	for _, key := range sortedKeys(envelope) {
+		switch key {
+		case "ok", "data", "error", "suggestions":
+		default:
+			report.UnknownFields = append(report.UnknownFields, key)
+		}
+	}
+
+	ok, found := envelope["ok"]
+	if !found {
+		report.MissingFields = append(report.MissingFields, "ok")
+	}
+
+	switch ok {
+	case true:
+		if data, found := envelope["data"]; found {
+			checkValue(data, dataType, "data", &report)
+		} else {
+			report.MissingFields = append(report.MissingFields, "data")
+		}
+	case false:
+		if _, found := envelope["error"]; !found {
+			report.MissingFields = append(report.MissingFields, "error")
+		}
+	}
+
+	return report
+}
+
+// checkBody checks a response that is not wrapped in ApiResponse.
+func checkBody(endpoint string, raw []byte, typ reflect.Type) SchemaReport {
+	report := SchemaReport{Endpoint: endpoint}
+
+	var body any
+	if err := json.Unmarshal(raw, &body); err != nil {
+		report.DecodeError = err.Error()
+		return report
+	}
+
+	checkValue(body, typ, "", &report)
+
+	return report
+}
+
+// checkRaw checks a JSON document embedded in a string, such as the raw
+// entry of a search hit.
+func checkRaw(raw string, typ reflect.Type, path string, report *SchemaReport) {
+	var value any
+	if err := json.Unmarshal([]byte(raw), &value); err != nil {
+		if report.DecodeError == "" {
+			report.DecodeError = fmt.Sprintf("%s: %v", path, err)
+		}
+		return
+	}
+
+	checkValue(value, typ, path, report)
+}
+
+// optionalFields lists, by the type holding them, the fields the API may
+// leave out even though they are encoded without omitempty.
+var optionalFields = map[reflect.Type]map[string]bool{
+	reflect.TypeOf(Origin{}):    {"voice": true},
+	reflect.TypeOf(WordEntry{}): {"suggestions": true},
+}
+
+// OptionalField reports whether the API may leave out the JSON field name of
+// typ even though it is encoded without omitempty, such as the suggestions
+// of an entry that was found.
+func OptionalField(typ reflect.Type, name string) bool {
+	return optionalFields[typ][name]
+}
+
+// checkValue compares a decoded JSON value with the Go type it is decoded
+// into. Struct fields without omitempty are required unless OptionalField
+// says otherwise.
+func checkValue(value any, typ reflect.Type, path string, report *SchemaReport) {
+	for typ.Kind() == reflect.Pointer {
+		typ = typ.Elem()
+	}
+
+	switch typ.Kind() {
+	case reflect.Struct:
+		obj, ok := value.(map[string]any)
+		if !ok {
+			return
+		}
+
+		known := map[string]bool{}
+		for _, f := range jsonFields(typ) {
+			known[f.name] = true
+
+			v, found := obj[f.name]
+			if !found {
+				if f.required {
+					report.MissingFields = append(report.MissingFields, joinPath(path, f.name))
+				}
+				continue
+			}
+			checkValue(v, f.typ, joinPath(path, f.name), report)
+		}
+
+		for _, key := range sortedKeys(obj) {
+			if !known[key] {
+				report.UnknownFields = append(report.UnknownFields, joinPath(path, key))
+			}
+		}
+
+	case reflect.Slice:
+		items, ok := value.([]any)
+		if !ok {
+			return
+		}
+		for i, item := range items {
+			checkValue(item, typ.Elem(), fmt.Sprintf("%s[%d]", path, i), report)
+		}
+	}
+}
+
+type jsonField struct {
+	index    int
+	name     string
+	typ      reflect.Type
+	required bool
+}
+
+// jsonFields returns the JSON visible fields of a struct type, skipping the
+// unexported ones and those tagged "-".
+func jsonFields(typ reflect.Type) []jsonField {
+	var fields []jsonField
+
+	for i := 0; i < typ.NumField(); i++ {
+		f := typ.Field(i)
+		if !f.IsExported() {
+			continue
+		}
+
+		tag := f.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+
+		name, opts, _ := strings.Cut(tag, ",")
+		if name == "" {
+			name = f.Name
+		}
+
+		fields = append(fields, jsonField{
+			index:    i,
+			name:     name,
+			typ:      f.Type,
+			required: !strings.Contains(opts, "omitempty") && !OptionalField(typ, name),
+		})
+	}
+
+	return fields
+}
+
+func joinPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
+
+func sortedKeys(m map[string]any) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
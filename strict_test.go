@@ -0,0 +1,104 @@
+package rae
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestCheckEnvelope(t *testing.T) {
+	raw := []byte(`{
+		"ok": true,
+		"data": {
+			"word": "comer",
+			"meanings": [{
+				"senses": [{
+					"raw": "1. tr. Masticar.",
+					"meaning_number": 1,
+					"category": "verb",
+					"usage": "common",
+					"description": "Masticar.",
+					"synonyms": [],
+					"examples": ["comer pan"]
+				}]
+			}],
+			"frequency": 12
+		},
+		"trace_id": "abc"
+	}`)
+
+	report := checkEnvelope("/words/comer", raw, reflect.TypeOf(WordEntry{}))
+
+	wantUnknown := []string{"trace_id", "data.meanings[0].senses[0].examples", "data.frequency"}
+	if !reflect.DeepEqual(report.UnknownFields, wantUnknown) {
+		t.Errorf("unknown fields: want %v, got %v", wantUnknown, report.UnknownFields)
+	}
+
+	wantMissing := []string{"data.meanings[0].senses[0].antonyms"}
+	if !reflect.DeepEqual(report.MissingFields, wantMissing) {
+		t.Errorf("missing fields: want %v, got %v", wantMissing, report.MissingFields)
+	}
+}
+
+func TestCheckEnvelopeOptionalFields(t *testing.T) {
+	raw := []byte(`{
+		"ok": true,
+		"data": {
+			"word": "comer",
+			"meanings": [{
+				"origin": {"raw": "Del lat. comedĕre.", "type": "latin", "text": "comedĕre"},
+				"senses": []
+			}]
+		}
+	}`)
+
+	report := checkEnvelope("/words/comer", raw, reflect.TypeOf(WordEntry{}))
+	if len(report.MissingFields) != 0 {
+		t.Errorf("expected voice and suggestions to be optional, got %v", report.MissingFields)
+	}
+}
+
+func TestCheckEnvelopeNotFound(t *testing.T) {
+	report := checkEnvelope("/words/xyz", []byte(`{"ok": false, "suggestions": [], "status": 404}`), reflect.TypeOf(WordEntry{}))
+
+	if want := []string{"status"}; !reflect.DeepEqual(report.UnknownFields, want) {
+		t.Errorf("unknown fields: want %v, got %v", want, report.UnknownFields)
+	}
+	if want := []string{"error"}; !reflect.DeepEqual(report.MissingFields, want) {
+		t.Errorf("missing fields: want %v, got %v", want, report.MissingFields)
+	}
+}
+
+func TestCheckRaw(t *testing.T) {
+	var report SchemaReport
+	checkRaw(`{"word": "comer", "meanings": [], "frequency": 12}`, reflect.TypeOf(WordEntry{}), "[0].doc.raw", &report)
+
+	if want := []string{"[0].doc.raw.frequency"}; !reflect.DeepEqual(report.UnknownFields, want) {
+		t.Errorf("unknown fields: want %v, got %v", want, report.UnknownFields)
+	}
+
+	checkRaw(`{"word"`, reflect.TypeOf(WordEntry{}), "[1].doc.raw", &report)
+	if report.DecodeError == "" {
+		t.Error("expected a decode error for a truncated document")
+	}
+}
+
+func TestStrictModeHandle(t *testing.T) {
+	var reported []SchemaReport
+	mode := StrictMode{
+		OnReport: func(r SchemaReport) { reported = append(reported, r) },
+		Fail:     true,
+	}
+
+	if err := mode.handle(SchemaReport{Endpoint: "/daily"}); err != nil {
+		t.Fatalf("expected empty reports to pass, got %v", err)
+	}
+
+	err := mode.handle(SchemaReport{Endpoint: "/daily", UnknownFields: []string{"data.date"}})
+	if !errors.Is(err, ErrSchemaDrift) {
+		t.Fatalf("expected ErrSchemaDrift, got %v", err)
+	}
+	if len(reported) != 1 {
+		t.Errorf("expected a single report, got %d", len(reported))
+	}
+}
@@ -0,0 +1,113 @@
+// Package suggest proposes headwords close to a misspelt word from a local
+// word list, so "did you mean" works offline and when the API has no
+// suggestions.
+package suggest
+
+import (
+	"github.com/rae-api-com/go-rae/collate"
+)
+
+// Edit costs in tenths. Spelling mistakes a Spanish speaker makes often,
+// because both spellings sound the same, are cheap.
+const (
+	costEdit   = 10 // insertion, deletion or substitution
+	costAccent = 1  // á for a, ü for u
+	costSound  = 3  // b/v, c/z/s, g/j, y/ll, a dropped h
+	costEnye   = 5  // n for ñ, common on keyboards without ñ
+
+	unitsPerEdit = 10.0
+)
+
+// homophones are pairs of letters that often sound the same.
+var homophones = map[[2]rune]bool{
+	{'b', 'v'}: true,
+	{'c', 'z'}: true,
+	{'c', 's'}: true,
+	{'s', 'z'}: true,
+	{'g', 'j'}: true,
+	{ll, 'y'}:  true,
+}
+
+func substitution(a, b rune) int {
+	switch {
+	case a == b:
+		return 0
+	case homophones[[2]rune{a, b}] || homophones[[2]rune{b, a}]:
+		return costSound
+	case (a == 'n' && b == 'ñ') || (a == 'ñ' && b == 'n'):
+		return costEnye
+	case collate.StripAccents(string(a)) == collate.StripAccents(string(b)):
+		return costAccent
+	}
+	return costEdit
+}
+
+func indel(r rune) int {
+	if r == 'h' {
+		return costSound
+	}
+	return costEdit
+}
+
+// Distance is a weighted edit distance between two words, 0 for equal
+// words and 1 for each plain edit. Accent differences cost 0.1 and the
+// homophone substitutions b/v, c/z/s, g/j, y/ll and a dropped h cost 0.3.
+// Case is ignored.
+func Distance(a, b string) float64 {
+	return float64(distance(a, b)) / unitsPerEdit
+}
+
+func distance(a, b string) int {
+	return tokenDistance(tokens(a), tokens(b))
+}
+
+// ll is the token standing for the digraph ll, so that ll and y are a
+// single substitution. Any single rune outside of Spanish words would do.
+const ll = 'ʎ'
+
+// tokens folds word and splits it into letters, reading ll as one.
+func tokens(word string) []rune {
+	runes := []rune(collate.Fold(word))
+
+	out := runes[:0]
+	for i := 0; i < len(runes); i++ {
+		if runes[i] == 'l' && i+1 < len(runes) && runes[i+1] == 'l' {
+			out = append(out, ll)
+			i++
+			continue
+		}
+		out = append(out, runes[i])
+	}
+
+	return out
+}
+
+// tokenDistance is the weighted Levenshtein distance. The costs are
+// symmetric and satisfy the triangle inequality, so the distance is a
+// metric as the BK-tree requires. Transpositions are left out for the same
+// reason.
+func tokenDistance(a, b []rune) int {
+	// d[i][j] is the distance between a[:i] and b[:j]
+	d := make([][]int, len(a)+1)
+	for i := range d {
+		d[i] = make([]int, len(b)+1)
+	}
+	for i := 1; i <= len(a); i++ {
+		d[i][0] = d[i-1][0] + indel(a[i-1])
+	}
+	for j := 1; j <= len(b); j++ {
+		d[0][j] = d[0][j-1] + indel(b[j-1])
+	}
+
+	for i := 1; i <= len(a); i++ {
+		for j := 1; j <= len(b); j++ {
+			d[i][j] = min(
+				d[i-1][j]+indel(a[i-1]),
+				d[i][j-1]+indel(b[j-1]),
+				d[i-1][j-1]+substitution(a[i-1], b[j-1]),
+			)
+		}
+	}
+
+	return d[len(a)][len(b)]
+}
@@ -0,0 +1,161 @@
+package suggest
+
+import (
+	"sort"
+	"sync"
+
+	rae "github.com/rae-api-com/go-rae"
+	"github.com/rae-api-com/go-rae/collate"
+)
+
+// Candidate is a suggested headword and its Distance to the input.
+type Candidate struct {
+	Word     string  `json:"word"`
+	Distance float64 `json:"distance"`
+}
+
+// node is a BK-tree node. Children are keyed by their distance to word.
+type node struct {
+	word     string
+	children map[int]*node
+}
+
+// Index is a BK-tree over a word list. It is safe for concurrent use.
+type Index struct {
+	// MaxDistance and Limit are used by Suggest. They default to 2 and 10
+	// when zero.
+	MaxDistance float64
+	Limit       int
+
+	mu   sync.RWMutex
+	root *node
+	size int
+}
+
+// NewIndex returns an index over words.
+func NewIndex(words ...string) *Index {
+	idx := &Index{}
+	idx.Add(words...)
+	return idx
+}
+
+// FromEntries returns an index over the headwords of entries, such as those
+// of a snapshot.
+func FromEntries(entries []rae.WordEntry) *Index {
+	words := make([]string, len(entries))
+	for i, e := range entries {
+		words[i] = e.Word
+	}
+	return NewIndex(words...)
+}
+
+func (idx *Index) Add(words ...string) {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+
+	for _, w := range words {
+		w = collate.Fold(w)
+		if w == "" {
+			continue
+		}
+		if idx.root == nil {
+			idx.root = &node{word: w}
+			idx.size++
+			continue
+		}
+
+		n := idx.root
+		for {
+			d := distance(w, n.word)
+			if d == 0 {
+				break
+			}
+			child, ok := n.children[d]
+			if !ok {
+				if n.children == nil {
+					n.children = map[int]*node{}
+				}
+				n.children[d] = &node{word: w}
+				idx.size++
+				break
+			}
+			n = child
+		}
+	}
+}
+
+// Len returns the number of distinct words in the index.
+func (idx *Index) Len() int {
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+	return idx.size
+}
+
+// Candidates returns up to limit words within maxDistance of word, closest
+// first and in alphabetical order for equal distances. A limit of zero or
+// less returns every match.
+func (idx *Index) Candidates(word string, maxDistance float64, limit int) []Candidate {
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+
+	if idx.root == nil {
+		return nil
+	}
+
+	word = collate.Fold(word)
+	max := int(maxDistance*unitsPerEdit + 0.5)
+
+	var found []Candidate
+	stack := []*node{idx.root}
+	for len(stack) > 0 {
+		n := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+
+		d := distance(word, n.word)
+		if d <= max {
+			found = append(found, Candidate{Word: n.word, Distance: float64(d) / unitsPerEdit})
+		}
+
+		for cd, child := range n.children {
+			if cd >= d-max && cd <= d+max {
+				stack = append(stack, child)
+			}
+		}
+	}
+
+	sort.Slice(found, func(i, j int) bool {
+		if found[i].Distance != found[j].Distance {
+			return found[i].Distance < found[j].Distance
+		}
+		return collate.Compare(found[i].Word, found[j].Word) < 0
+	})
+
+	if limit > 0 && len(found) > limit {
+		found = found[:limit]
+	}
+
+	return found
+}
+
+// Suggest returns the words closest to word other than word itself, using
+// MaxDistance and Limit. It implements rae.Suggester.
+func (idx *Index) Suggest(word string) []string {
+	maxDistance, limit := idx.MaxDistance, idx.Limit
+	if maxDistance <= 0 {
+		maxDistance = 2
+	}
+	if limit <= 0 {
+		limit = 10
+	}
+
+	folded := collate.Fold(word)
+
+	var words []string
+	for _, c := range idx.Candidates(word, maxDistance, limit+1) {
+		if c.Word != folded && len(words) < limit {
+			words = append(words, c.Word)
+		}
+	}
+
+	return words
+}
@@ -0,0 +1,122 @@
+package suggest
+
+import (
+	"context"
+	"math"
+	"slices"
+	"testing"
+
+	rae "github.com/rae-api-com/go-rae"
+	"github.com/rae-api-com/go-rae/raetest"
+)
+
+func TestDistance(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want float64
+	}{
+		{"casa", "casa", 0},
+		{"Casa", "casa", 0},
+		{"cancion", "canción", 0.1},
+		{"pinguino", "pingüino", 0.1},
+		{"vaca", "baca", 0.3},
+		{"cazar", "casar", 0.3},
+		{"cielo", "sielo", 0.3},
+		{"jente", "gente", 0.3},
+		{"yuvia", "lluvia", 0.3},
+		{"ablar", "hablar", 0.3},
+		{"nino", "niño", 0.5},
+		{"perro", "pero", 1},
+		{"gato", "pato", 1},
+		{"", "sol", 3},
+	}
+
+	for _, tt := range tests {
+		got := Distance(tt.a, tt.b)
+		if math.Abs(got-tt.want) > 1e-9 {
+			t.Errorf("Distance(%q, %q) = %v, want %v", tt.a, tt.b, got, tt.want)
+		}
+		if back := Distance(tt.b, tt.a); back != got {
+			t.Errorf("Distance is not symmetric for %q and %q: %v, %v", tt.a, tt.b, got, back)
+		}
+	}
+}
+
+var words = []string{
+	"hablar", "habla", "abollar", "lluvia", "llover", "vaca", "baca", "bacalao",
+	"canción", "cansado", "casa", "caza", "cazar", "casar", "gente", "niño", "ninfa",
+	"perro", "pero", "pera", "gato", "pato", "árbol", "arbusto", "huevo", "uva",
+}
+
+func TestCandidatesMatchBruteForce(t *testing.T) {
+	idx := NewIndex(words...)
+	if idx.Len() != len(words) {
+		t.Fatalf("expected %d words, got %d", len(words), idx.Len())
+	}
+
+	for _, input := range []string{"ablar", "yuvia", "baca", "cancion", "kasa", "arbol", "webo", "zzz"} {
+		for _, maxDistance := range []float64{0.3, 1, 2} {
+			var want []string
+			for _, w := range words {
+				if Distance(input, w) <= maxDistance+1e-9 {
+					want = append(want, w)
+				}
+			}
+
+			var got []string
+			for _, c := range idx.Candidates(input, maxDistance, 0) {
+				got = append(got, c.Word)
+			}
+
+			slices.Sort(want)
+			slices.Sort(got)
+			if !slices.Equal(got, want) {
+				t.Errorf("%q within %v: got %v, want %v", input, maxDistance, got, want)
+			}
+		}
+	}
+}
+
+func TestSuggest(t *testing.T) {
+	idx := NewIndex(words...)
+	idx.Limit = 3
+
+	if got := idx.Suggest("ablar"); !slices.Equal(got, []string{"hablar", "habla", "abollar"}) {
+		t.Errorf("unexpected ranking %v", got)
+	}
+	if got := idx.Suggest("vaca"); got[0] != "baca" {
+		t.Errorf("the word itself must not be suggested: %v", got)
+	}
+	if got := idx.Suggest("xyzxyz"); len(got) != 0 {
+		t.Errorf("expected no suggestions, got %v", got)
+	}
+}
+
+func TestClientFallback(t *testing.T) {
+	server := raetest.NewServer()
+	defer server.Close()
+
+	idx := FromEntries(raetest.Entries())
+	cli := rae.New(rae.WithBaseURL(server.URL), rae.WithSuggester(idx))
+
+	// the fake server suggests words sharing the first letter, none here
+	entry, err := cli.Word(context.Background(), "ablar")
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+	if !slices.Equal(entry.Suggestions, []string{"hablar"}) {
+		t.Errorf("expected local suggestions, got %v", entry.Suggestions)
+	}
+
+	// the suggestions of the API are kept
+	entry, _ = cli.Word(context.Background(), "cas")
+	if !slices.Contains(entry.Suggestions, "camión") {
+		t.Errorf("expected the API suggestions, got %v", entry.Suggestions)
+	}
+
+	server.Close()
+	entry, err = cli.Word(context.Background(), "abla")
+	if err == nil || !slices.Equal(entry.Suggestions, []string{"hablar"}) {
+		t.Errorf("expected local suggestions offline, got %v, %v", entry.Suggestions, err)
+	}
+}